@@ -32,6 +32,9 @@ type Base struct {
 
 	cfg   *WidgetBaseConfig
 	theme *Theme
+	style *Style
+	class string
+	text  TextStyle
 
 	HeightCaculator func() int
 
@@ -62,12 +65,15 @@ func (b *Base) controlHeight(extended bool) int {
 	}
 
 	h := b.theme.ControlH
+	if b.text != TextBody {
+		h = b.theme.Typeface(b.text).Height
+	}
 	if b.HeightCaculator != nil {
 		h = b.HeightCaculator()
 	}
 
 	if ok, _ := b.IsInvalid(); ok && extended {
-		m := b.theme.ErrorText().Measure(" ")
+		m := b.theme.ErrorTextFace().Measure(" ")
 		h += b.theme.ErrorGap + m.IntHeight()
 	}
 
@@ -85,7 +91,7 @@ func (b *Base) ErrorRect() image.Rectangle {
 		return image.Rectangle{}
 	}
 
-	m := b.theme.ErrorText().Measure(" ")
+	m := b.theme.ErrorTextFace().Measure(" ")
 	h := b.theme.ErrorGap + m.IntHeight()
 
 	return image.Rect(r.Min.X, r.Max.Y+b.theme.ErrorGap, r.Max.X, r.Max.Y+h)
@@ -112,13 +118,20 @@ func (b *Base) SetFrame(x, y, w int) {
 		w = 0
 	}
 
-	b.rect = image.Rect(x, y, x+w, y+b.requiredHeight())
+	// Set the width first: variable-height widgets derive their height from it.
+	b.rect = image.Rect(x, y, x+w, y)
+	b.rect.Max.Y = y + b.requiredHeight()
+}
+
+// Width is the frame width set with SetFrame.
+func (b *Base) Width() int {
+	return b.rect.Dx()
 }
 
 func (b *Base) requiredHeight() int {
 	h := b.controlHeight(true)
 	if ok, _ := b.IsInvalid(); ok {
-		m := b.theme.ErrorText().Measure(" ")
+		m := b.theme.ErrorTextFace().Measure(" ")
 		h += b.theme.ErrorGap + m.IntHeight()
 	}
 
@@ -158,16 +171,20 @@ func (c *Base) DrawSurfece(ctx *Context, dst *ebiten.Image, r image.Rectangle) {
 		return
 	}
 
-	bg := ctx.Theme().SurfaceColor
-	if !c.enabled {
-		bg = ctx.Theme().SurfacePressedColor
-	} else if c.pressed {
-		bg = ctx.Theme().SurfacePressedColor
-	} else if c.hovered {
-		bg = ctx.Theme().SurfaceHoverColor
+	st := c.ResolveStyle(ctx.Theme())
+	state := c.State()
+	if st.Skin != nil {
+		if n := st.Skin.Get(state, c.focused); n != nil {
+			n.Draw(dst, r, ctx.Theme().Scale)
+			return
+		}
+	}
+
+	if st.SurfaceOnHover && state != StateHover && state != StatePressed {
+		return
 	}
 
-	drawRoundedRect(dst, r, ctx.Theme().Radius, bg)
+	drawRoundedRect(dst, r, max(st.Radius, 0), st.Surface.Get(state))
 }
 
 func (c *Base) DrawBoder(ctx *Context, dst *ebiten.Image, r image.Rectangle) {
@@ -175,15 +192,18 @@ func (c *Base) DrawBoder(ctx *Context, dst *ebiten.Image, r image.Rectangle) {
 		return
 	}
 
-	border := ctx.Theme().BorderColor
-	if !c.enabled {
-		border = ctx.Theme().DisabledColor
+	st := c.ResolveStyle(ctx.Theme())
+	skinned := st.Skin != nil && st.Skin.Get(c.State(), c.focused) != nil
+	if (st.HideBorder || skinned) && !c.invalid {
+		return
 	}
+
+	border := st.Border.Get(c.State())
 	if c.invalid {
 		border = ctx.Theme().ErrorBorderColor
 	}
 
-	drawRoundedBorder(dst, r, ctx.Theme().Radius, ctx.Theme().BorderW, border)
+	drawRoundedBorder(dst, r, max(st.Radius, 0), st.BorderW, border)
 }
 
 func (c *Base) DrawFocus(ctx *Context, dst *ebiten.Image, r image.Rectangle) {
@@ -195,7 +215,76 @@ func (c *Base) DrawFocus(ctx *Context, dst *ebiten.Image, r image.Rectangle) {
 		return
 	}
 
-	drawRoundedBorder(dst, r, ctx.Theme().Radius, ctx.Theme().FocusRingW, ctx.Theme().FocusColor)
+	// The ring is left out only when the Focused slice, which draws its own, is shown.
+	st := c.ResolveStyle(ctx.Theme())
+	if st.Skin != nil && st.Skin.Focused != nil && st.Skin.Get(c.State(), true) == st.Skin.Focused {
+		return
+	}
+
+	drawRoundedBorder(dst, r, max(st.Radius, 0), ctx.Theme().FocusRingW, st.Focus)
+}
+
+// State is the current interaction state, used to pick Style colors.
+func (c *Base) State() WidgetState {
+	switch {
+	case !c.enabled:
+		return StateDisabled
+	case c.pressed:
+		return StatePressed
+	case c.hovered:
+		return StateHover
+	}
+
+	return StateNormal
+}
+
+// SetStyle attaches a style to this widget; its set fields override the class and theme.
+func (c *Base) SetStyle(s Style) {
+	c.style = &s
+}
+
+// ClearStyle removes the style set with SetStyle.
+func (c *Base) ClearStyle() {
+	c.style = nil
+}
+
+// SetClass makes the widget use the named style class of its theme (see Theme.Class).
+func (c *Base) SetClass(name string) {
+	c.class = name
+}
+
+func (c *Base) Class() string {
+	return c.class
+}
+
+// SetTextStyle picks the step of the theme typographic scale the widget text uses.
+// It also sets the widget height (see Typeface).
+func (c *Base) SetTextStyle(s TextStyle) {
+	c.text = s
+}
+
+func (c *Base) TextStyle() TextStyle {
+	return c.text
+}
+
+// ResolveStyle merges, from lowest to highest precedence, the theme defaults, the
+// built-in class, the text style, the class registered in Theme.Classes and the widget style.
+func (c *Base) ResolveStyle(t *Theme) Style {
+	st := t.DefaultStyle()
+	if bs, ok := t.builtinClass(c.class); ok && c.class != "" {
+		st = st.merge(bs)
+	}
+
+	st = st.merge(t.TypeStyle(c.text))
+	if cs, ok := t.Classes[c.class]; ok && c.class != "" {
+		st = st.merge(cs)
+	}
+
+	if c.style != nil {
+		st = st.merge(*c.style)
+	}
+
+	return st
 }
 
 func (c *Base) DrawInvalid(ctx *Context, dst *ebiten.Image, r image.Rectangle) {
@@ -215,6 +304,12 @@ func (c *Base) Theme() *Theme {
 	return c.theme
 }
 
+// SetTheme replaces the theme used for sizing. Use Context.SetTheme to switch a whole UI.
+func (c *Base) SetTheme(t *Theme) {
+	c.theme = t
+	c.cfg.Theme = t
+}
+
 func (c *Base) DrawRoundedRect(dst *ebiten.Image, r image.Rectangle, radius int, col color.RGBA) {
 	drawRoundedRect(dst, r, radius, col)
 }
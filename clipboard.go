@@ -0,0 +1,22 @@
+package uikit
+
+// Clipboard is implemented by the app to give widgets access to the system clipboard
+// (Ebiten has no clipboard API), e.g. with golang.design/x/clipboard.
+type Clipboard interface {
+	WriteText(s string)
+}
+
+// SetClipboard registers the clipboard used by Copy.
+func (c *Context) SetClipboard(cb Clipboard) {
+	c.clipboard = cb
+}
+
+// Copy writes s to the clipboard. It reports false when no clipboard is registered.
+func (c *Context) Copy(s string) bool {
+	if c.clipboard == nil {
+		return false
+	}
+
+	c.clipboard.WriteText(s)
+	return true
+}
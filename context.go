@@ -1,6 +1,10 @@
 package uikit
 
 import (
+	"image"
+	"math"
+	"time"
+
 	"github.com/erparts/go-uikit/common"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
@@ -8,19 +12,45 @@ import (
 
 // Context holds shared state for all widgets.
 type Context struct {
-	root    Layout
-	theme   *Theme
-	ime     IMEBridge
-	widgets []Widget
-	focus   int // -1 means none
-
-	ptr         *PointerStatus
-	hasTouch    bool
-	prevTouches map[ebiten.TouchID]struct{}
+	root      Layout
+	theme     *Theme
+	ime       IMEBridge
+	clipboard Clipboard
+	widgets   []Widget
+	parents   map[Widget]Widget
+	focus     int // -1 means none
+
+	ptr           *PointerStatus
+	pressConsumed bool // the current press was taken before reaching the widgets
+	hasTouch      bool
+	prevTouches   map[ebiten.TouchID]struct{}
+
+	// DragThreshold is how far (in logical units) a press must move before a scroll drag
+	// takes it over and the pressed widget is released without a click.
+	DragThreshold int
+
+	scrollGesture *Scroller // scroller owning the current drag, if any
+
+	// TooltipDelay is the hover (or long-press on touch) time before a tooltip is shown.
+	TooltipDelay time.Duration
+
+	tooltips map[Widget]tooltip
+	tip      tooltipState
+
+	// AutoScale makes Layout follow the monitor's device scale factor.
+	AutoScale bool
+
+	scale float64
+
+	// ToastCorner is the screen corner where Notify stacks its toasts.
+	ToastCorner Corner
+
+	toasts []*toast
+	screen image.Rectangle // last drawn screen bounds
 }
 
 func NewContext(theme *Theme, root Layout, ime IMEBridge) *Context {
-	root.SetPadding(theme.SpaceL, theme.SpaceL)
+	root.SetPadding(theme.Logical(theme.SpaceL), theme.Logical(theme.SpaceL))
 
 	return &Context{
 		theme:       theme,
@@ -29,7 +59,14 @@ func NewContext(theme *Theme, root Layout, ime IMEBridge) *Context {
 		prevTouches: map[ebiten.TouchID]struct{}{},
 		root:        root,
 		widgets:     []Widget{root},
+		parents:     map[Widget]Widget{},
 		ptr:         &PointerStatus{},
+
+		DragThreshold: DefaultDragThreshold,
+		scale:         theme.Scale,
+
+		TooltipDelay: DefaultTooltipDelay,
+		tooltips:     map[Widget]tooltip{},
 	}
 }
 
@@ -37,6 +74,82 @@ func (c *Context) Theme() *Theme {
 	return c.theme
 }
 
+// SetTheme switches the theme of a live UI. Every widget in the tree gets t; sizes are
+// recomputed on the next frame.
+func (c *Context) SetTheme(t *Theme) {
+	if t == nil || t == c.theme {
+		return
+	}
+
+	if t.Scale != c.Scale() {
+		t = t.Scaled(c.Scale())
+	}
+
+	c.theme = t
+	c.root.SetPadding(t.Logical(t.SpaceL), t.Logical(t.SpaceL))
+
+	var walk func(w Widget)
+	walk = func(w Widget) {
+		if ts, ok := any(w).(ThemeSetter); ok {
+			ts.SetTheme(t)
+		}
+
+		if hw, ok := any(w).(interface{ Children() []Widget }); ok {
+			for _, ch := range hw.Children() {
+				walk(ch)
+			}
+		}
+	}
+
+	walk(c.root)
+	for _, tip := range c.tooltips {
+		if tip.widget != nil {
+			walk(tip.widget)
+		}
+	}
+}
+
+// Scale is the device scale factor the UI is laid out for.
+func (c *Context) Scale() float64 {
+	if c.scale <= 0 {
+		return 1
+	}
+
+	return c.scale
+}
+
+// SetScale changes the device scale factor at runtime: the theme is replaced by a copy
+// with every size derived again for s (see Theme.Scaled).
+func (c *Context) SetScale(s float64) {
+	if s <= 0 || s == c.Scale() {
+		return
+	}
+
+	c.scale = s
+	c.SetTheme(c.theme.Scaled(s))
+}
+
+// Dp converts a length in logical units to screen pixels, e.g. to draw in a Container.
+// Sizes passed to widgets and layouts are logical already.
+func (c *Context) Dp(v int) int {
+	return int(math.Round(float64(v) * c.Scale()))
+}
+
+// Layout is meant to be returned from ebiten.Game.Layout. It makes the screen as large
+// as the window in device pixels, so text stays sharp on HiDPI screens, and pointer
+// coordinates map 1:1 to it. With AutoScale it first follows the monitor's device
+// scale factor, e.g. when the window moves to another monitor.
+func (c *Context) Layout(outsideW, outsideH int) (int, int) {
+	if c.AutoScale {
+		if m := ebiten.Monitor(); m != nil && m.DeviceScaleFactor() > 0 {
+			c.SetScale(m.DeviceScaleFactor())
+		}
+	}
+
+	s := c.Scale()
+	return int(math.Ceil(float64(outsideW) * s)), int(math.Ceil(float64(outsideH) * s))
+}
+
 // Root returns the root widget (typically a Layout).
 func (c *Context) Root() Layout {
 	return c.root
@@ -64,30 +177,70 @@ func (c *Context) Focused() Widget {
 // Pointer returns the current pointer state in logical pixels.
 // On desktop this is the mouse; on mobile this is the active touch.
 
+// rebuildWidgets flattens the widget tree. Focus follows the focused widget, not its
+// index, so it survives layouts that move their children around (e.g. Responsive).
 func (c *Context) rebuildWidgets() {
+	focused := c.Focused()
+
 	c.widgets = c.widgets[:0]
-	var walk func(w Widget)
-	walk = func(w Widget) {
+	clear(c.parents)
+
+	var walk func(w, parent Widget)
+	walk = func(w, parent Widget) {
 		if w == nil {
 			return
 		}
 
 		c.widgets = append(c.widgets, w)
+		c.parents[w] = parent
+
+		// Widgets inside a hidden one can't be hovered or focused.
+		if !w.IsVisible() {
+			return
+		}
 
 		if hw, ok := any(w).(interface{ Children() []Widget }); ok {
 			for _, ch := range hw.Children() {
-				walk(ch)
+				walk(ch, w)
 			}
 		}
 	}
 
 	for _, w := range c.root.Children() {
-		walk(w)
+		walk(w, c.root)
+	}
+
+	c.focus = -1
+	for i, w := range c.widgets {
+		if w == focused {
+			c.focus = i
+			break
+		}
+	}
+
+	if focused != nil && c.focus < 0 {
+		focused.SetFocused(false)
+		focused.Dispatch(Event{Widget: focused, Type: EventFocusLost})
+		c.updateIME(focused, nil)
 	}
 }
 
+// Pointer returns the pointer state of this frame. IsJustDown is false once the
+// press is consumed.
 func (c *Context) Pointer() PointerStatus {
-	return *c.ptr
+	p := *c.ptr
+	p.IsJustDown = c.justDown()
+	return p
+}
+
+// ConsumePress hides the current press from the widgets updated after the caller and
+// from pointer-down routing, e.g. when a scrollbar or a toast takes it.
+func (c *Context) ConsumePress() {
+	c.pressConsumed = true
+}
+
+func (c *Context) justDown() bool {
+	return c.ptr.IsJustDown && !c.pressConsumed
 }
 
 func (c *Context) SetFocus(w Widget) {
@@ -163,6 +316,48 @@ func (c *Context) updateIMEForce(focused Widget) {
 	}
 }
 
+// ScrollIntoView scrolls every scrolling ancestor of w just enough to make it visible.
+// Scrollers with Smooth set animate there.
+func (c *Context) ScrollIntoView(w Widget) {
+	if w == nil {
+		return
+	}
+
+	r := w.Measure(false)
+	for p := c.parents[w]; p != nil; p = c.parents[p] {
+		sc, ok := any(p).(Scrollable)
+		if !ok {
+			continue
+		}
+
+		s := sc.Scroller()
+		vp := p.Measure(false)
+		dx := scrollDelta(r.Min.X, r.Max.X, vp.Min.X, vp.Max.X)
+		dy := scrollDelta(r.Min.Y, r.Max.Y, vp.Min.Y, vp.Max.Y)
+		if dx == 0 && dy == 0 {
+			continue
+		}
+
+		s.ScrollTo(s.ScrollX+dx, s.ScrollY+dy)
+
+		// Outer ancestors see w where this scroll leaves it.
+		r = r.Sub(image.Pt(dx, dy))
+	}
+}
+
+// scrollDelta is the scroll needed to fit [lo, hi) into [vlo, vhi), preferring
+// the start when it doesn't fit.
+func scrollDelta(lo, hi, vlo, vhi int) int {
+	switch {
+	case lo < vlo:
+		return lo - vlo
+	case hi > vhi:
+		return min(hi-vhi, lo-vlo)
+	}
+
+	return 0
+}
+
 func (c *Context) focusNext() {
 	if len(c.widgets) == 0 {
 		c.SetFocus(nil)
@@ -173,6 +368,7 @@ func (c *Context) focusNext() {
 		idx := (start + 1 + i) % len(c.widgets)
 		if c.widgets[idx].IsVisible() && c.widgets[idx].IsEnabled() && c.widgets[idx].Focusable() {
 			c.SetFocus(c.widgets[idx])
+			c.ScrollIntoView(c.widgets[idx])
 			return
 		}
 	}
@@ -191,6 +387,7 @@ func (c *Context) focusPrev() {
 		}
 		if c.widgets[idx].IsVisible() && c.widgets[idx].IsEnabled() && c.widgets[idx].Focusable() {
 			c.SetFocus(c.widgets[idx])
+			c.ScrollIntoView(c.widgets[idx])
 			return
 		}
 	}
@@ -200,6 +397,7 @@ func (c *Context) readPointerSnapshot() {
 	c.ptr.IsJustDown = false
 	c.ptr.IsJustUp = false
 	c.ptr.IsTouch = false
+	c.pressConsumed = false
 
 	// Touch tracking (prefer this on mobile; CursorPosition is always (0,0) there).
 	touches := ebiten.TouchIDs()
@@ -259,6 +457,8 @@ func (c *Context) widgetHit(w Widget, x, y int) bool {
 	return common.Contains(w.Measure(false), x, y)
 }
 
+// topmostAt returns the last hit widget in tree order. Layouts return Children() back
+// to front (the order they draw in), so z-ordered layouts like Anchor are respected.
 func (c *Context) topmostAt(x, y int) Widget {
 	for i := len(c.widgets) - 1; i >= 0; i-- {
 		w := c.widgets[i]
@@ -274,8 +474,26 @@ func (c *Context) topmostAt(x, y int) Widget {
 	return nil
 }
 
+// CancelPress releases the pressed widget without a click, e.g. when a scroll drag
+// takes over a press that started on a button.
+func (c *Context) CancelPress() {
+	for _, w := range c.widgets {
+		if !w.IsPressed() {
+			continue
+		}
+
+		w.SetPressed(false)
+		w.Dispatch(Event{Widget: w, Type: EventPointerUp, Pointer: c.ptr})
+	}
+}
+
 func (c *Context) Update() {
 	c.readPointerSnapshot()
+	if c.ptr.IsJustDown {
+		c.scrollGesture = nil
+	}
+
+	c.updateToasts()
 	c.root.Update(c)
 
 	c.rebuildWidgets()
@@ -288,7 +506,7 @@ func (c *Context) Update() {
 		}
 	}
 
-	if c.ptr.IsJustDown {
+	if c.justDown() {
 		w := c.topmostAt(c.ptr.X, c.ptr.Y)
 		if w != nil && w.Focusable() && w.IsEnabled() {
 			c.SetFocus(w)
@@ -298,7 +516,7 @@ func (c *Context) Update() {
 	}
 
 	var target Widget
-	if c.ptr.IsJustDown {
+	if c.justDown() {
 		target = c.topmostAt(c.ptr.X, c.ptr.Y)
 	}
 
@@ -315,7 +533,7 @@ func (c *Context) Update() {
 		w.SetHovered(hoverTarget == w)
 
 		// Pointer down routed to the chosen target.
-		if c.ptr.IsJustDown && target == w && w.IsEnabled() {
+		if target == w && w.IsEnabled() {
 			w.SetPressed(true)
 			w.Dispatch(Event{Widget: w, Type: EventPointerDown, Pointer: c.ptr})
 		}
@@ -336,6 +554,8 @@ func (c *Context) Update() {
 
 		w.SetFocused((c.Focused() == w) && w.IsEnabled() && w.Focusable())
 	}
+
+	c.updateTooltip(hoverTarget)
 }
 
 func (c *Context) Draw(dst *ebiten.Image) {
@@ -343,8 +563,12 @@ func (c *Context) Draw(dst *ebiten.Image) {
 		return
 	}
 
-	c.root.SetHeight(dst.Bounds().Dy())
+	c.screen = dst.Bounds()
+
+	c.root.SetFrameHeight(dst.Bounds().Dy())
 	c.root.SetFrame(0, 0, dst.Bounds().Dx())
 	c.root.Draw(c, dst)
 	c.root.DrawOverlay(c, dst)
+	c.drawToasts(dst)
+	c.drawTooltip(dst)
 }
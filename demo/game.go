@@ -2,9 +2,11 @@ package demo
 
 import (
 	"fmt"
+	"image/color"
 	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 	"github.com/tinne26/etxt"
 
 	"github.com/erparts/go-uikit"
@@ -13,13 +15,16 @@ import (
 )
 
 type Game struct {
-	stack *layout.Stack
-	grid  *layout.Grid
-	ime   uikit.IMEBridge
+	stack      *layout.Stack
+	grid       *layout.Grid
+	responsive *layout.Responsive
+	row        *layout.Row
+	ime        uikit.IMEBridge
 
 	theme *uikit.Theme
 	ctx   *uikit.Context
 
+	menu         *widget.MenuBar
 	title        *widget.Label
 	txtA         *widget.TextInput
 	txtB         *widget.TextInput
@@ -29,13 +34,14 @@ type Game struct {
 	box          *widget.Container
 	chkA         *widget.Checkbox
 	chkDis       *widget.Checkbox
-	chkGrid      *widget.Checkbox
 	btnA         *widget.Button
 	btnDis       *widget.Button
 	focusInfo    *widget.Label
 	exampleLabel *widget.Label
+	notes        *widget.RichLabel
 
 	clickCount int
+	lastAction string
 }
 
 func New() *Game {
@@ -59,12 +65,56 @@ func (g *Game) initOnce() {
 
 	root := layout.NewStack(g.theme)
 	g.ctx = uikit.NewContext(g.theme, root, g.ime)
+	g.ctx.AutoScale = true
 	g.stack = layout.NewStack(g.theme)
 
 	g.grid = layout.NewGrid(g.theme)
-	g.grid.SetVisible(false)
+
+	// Single column on phones, two columns from tablet width up.
+	g.responsive = layout.NewResponsive(g.theme)
+	g.responsive.AddBreakpoint(0, g.stack, nil)
+	g.responsive.AddBreakpoint(720, g.grid, nil)
+
+	action := func(name string) func() {
+		return func() { g.lastAction = name }
+	}
+
+	themes := map[string]*uikit.Theme{
+		"Dark":          g.theme,
+		"Light":         g.theme.Light(),
+		"High Contrast": g.theme.HighContrast(),
+	}
+
+	useTheme := func(name string) func() {
+		return func() {
+			g.ctx.SetTheme(themes[name])
+			g.lastAction = "View > " + name + " Theme"
+		}
+	}
+
+	g.menu = widget.NewMenuBar(g.theme, []*widget.Menu{
+		{Label: "File", Items: []widget.MenuItem{
+			{Label: "New", Shortcut: "Ctrl+N", OnSelect: action("File > New")},
+			{Label: "Open…", Shortcut: "Ctrl+O", OnSelect: action("File > Open")},
+			{Separator: true},
+			{Label: "Quit", OnSelect: action("File > Quit")},
+		}},
+		{Label: "Edit", Items: []widget.MenuItem{
+			{Label: "Undo", Shortcut: "Ctrl+Z", OnSelect: action("Edit > Undo")},
+			{Label: "Redo", Shortcut: "Ctrl+Y", Disabled: true},
+		}},
+		{Label: "View", Items: []widget.MenuItem{
+			{Label: "Zoom In", OnSelect: action("View > Zoom In")},
+			{Label: "Zoom Out", OnSelect: action("View > Zoom Out")},
+			{Separator: true},
+			{Label: "Dark Theme", OnSelect: useTheme("Dark")},
+			{Label: "Light Theme", OnSelect: useTheme("Light")},
+			{Label: "High Contrast Theme", OnSelect: useTheme("High Contrast")},
+		}},
+	})
 
 	g.title = widget.NewLabel(g.theme, "")
+	g.title.SetTextStyle(uikit.TextH2)
 	g.title.SetTextFunc(func() string {
 		return fmt.Sprintf("UI Kit Demo (TPS: %0.2f - FPS: %0.2f)", ebiten.ActualTPS(), ebiten.ActualFPS())
 	})
@@ -78,7 +128,19 @@ func (g *Game) initOnce() {
 		return "Focused: (none) — tap a widget or TAB"
 	})
 
-	g.exampleLabel = widget.NewLabel(g.theme, "Label example: static helper text")
+	g.focusInfo.SetTextStyle(uikit.TextCaption)
+
+	g.exampleLabel = widget.NewLabel(g.theme, "Label example: helper text wraps to the frame width, is cut after two lines and can be selected and copied. Hover it to read the full text in a tooltip.")
+	g.exampleLabel.SetWrap(true)
+	g.exampleLabel.SetMaxLines(2)
+	g.exampleLabel.SetSelectable(true)
+	g.exampleLabel.SetTruncationTooltip(true)
+
+	g.notes = widget.NewRichLabel(g.theme, "[b]Patch notes:[/b] [color=success]faster[/color] scrolling, [i]themes[/i] loaded with [code]LoadTheme[/code]. See the [url=changelog]full changelog[/url].")
+	g.notes.On(uikit.EventLinkClick, func(e uikit.Event) bool {
+		g.lastAction = "Link: " + e.Text
+		return true
+	}, false)
 
 	g.txtA = widget.NewTextInput(g.theme, "Type here…")
 
@@ -121,7 +183,7 @@ func (g *Game) initOnce() {
 	}, false)
 
 	g.box = widget.NewContainer(g.theme)
-	g.box.SetHeight(140)
+	g.box.SetHeight(160)
 	g.box.OnDraw = func(ctx *uikit.Context, dst *ebiten.Image) {
 		s, _ := g.sel.Selected()
 		lines := []string{
@@ -130,6 +192,7 @@ func (g *Game) initOnce() {
 			fmt.Sprintf("- Select Value: %s ", s.Label),
 			fmt.Sprintf("- Search Text: %s", g.txtB.Text()),
 			fmt.Sprintf("- TextArea Chars: %d", len([]rune(g.ta.Text()))),
+			fmt.Sprintf("- Last Menu Action: %s", g.lastAction),
 		}
 
 		t := ctx.Theme().Text()
@@ -149,37 +212,45 @@ func (g *Game) initOnce() {
 	g.chkDis.SetChecked(true)
 	g.chkDis.SetEnabled(false)
 
-	g.chkGrid = widget.NewCheckbox(g.theme, "Use grid layout")
-	g.chkGrid.On(uikit.EventValueChange, func(e uikit.Event) bool {
-		if e.Widget.(*widget.Checkbox).Checked() {
-			g.grid.SetVisible(true)
-			g.stack.SetVisible(false)
-			return false
-		}
-
-		g.stack.SetVisible(true)
-		g.grid.SetVisible(false)
-		return false
-	}, false)
-
 	g.btnA = widget.NewButton(g.theme, "Action (enabled)")
+	g.btnA.SetClass("primary")
+	g.btnA.SetLeadingIcon(plusIcon())
 	g.btnA.On(uikit.EventClick, func(_ uikit.Event) bool {
 		g.clickCount++
+		g.ctx.Notify(fmt.Sprintf("Clicked %d times", g.clickCount), uikit.NotifyOptions{
+			Severity:    uikit.SeveritySuccess,
+			ActionLabel: "Undo",
+			OnAction:    func() { g.clickCount-- },
+		})
 		return false
 	}, false)
 
 	g.btnDis = widget.NewButton(g.theme, "Action (disabled)")
 	g.btnDis.SetEnabled(false)
 
+	g.row = layout.NewRow(g.theme)
+	g.row.SetAlign(layout.AlignCenter)
+	g.row.Add(widget.NewLabel(g.theme, "Row:"))
+	g.row.AddItem(widget.NewTextInput(g.theme, "Fills the remaining width"), layout.RowItem{Mode: layout.SizeFill})
+
+	// A skinned button: nine-slices replace the surface and border, the focus ring stays.
+	goBtn := widget.NewButton(g.theme, "Go")
+	goBtn.SetStyle(uikit.Style{Skin: bevelSkin()})
+	g.row.Add(goBtn)
+
+	g.ctx.SetTooltip(g.btnA, "Increments the click counter")
+	g.ctx.SetTooltip(g.sel, "Pick any option but the first one")
+
+	g.ctx.Add(g.menu)
 	g.ctx.Add(g.title)
 	g.ctx.Add(g.focusInfo)
-	g.ctx.Add(g.chkGrid)
+	g.ctx.Add(g.row)
 
-	g.ctx.Add(g.stack)
-	g.ctx.Add(g.grid)
+	g.ctx.Add(g.responsive)
 
 	contentWidgets := []uikit.Widget{
 		g.exampleLabel,
+		g.notes,
 		g.txtA,
 		g.txtB,
 		g.txtDis,
@@ -192,8 +263,36 @@ func (g *Game) initOnce() {
 		g.btnDis,
 	}
 
-	g.stack.SetChildren(contentWidgets)
-	g.grid.SetChildren(contentWidgets)
+	g.responsive.SetChildren(contentWidgets)
+	g.grid.SetSpan(g.ta, 2, 1)
+	g.grid.SetSpan(g.box, 2, 1)
+}
+
+// plusIcon is a white "+" on transparent, tinted by the widgets that draw it.
+func plusIcon() *ebiten.Image {
+	img := ebiten.NewImage(16, 16)
+	vector.DrawFilledRect(img, 7, 2, 2, 12, color.White, false)
+	vector.DrawFilledRect(img, 2, 7, 12, 2, color.White, false)
+	return img
+}
+
+// bevelSkin is a raised bevel drawn at runtime, sunken while pressed.
+func bevelSkin() *uikit.Skin {
+	slice := func(face, light, dark color.RGBA) *uikit.NineSlice {
+		img := ebiten.NewImage(12, 12)
+		img.Fill(dark)
+		vector.DrawFilledRect(img, 0, 0, 10, 10, light, false)
+		vector.DrawFilledRect(img, 2, 2, 8, 8, face, false)
+		return uikit.NewNineSlice(img, 3, 3, 3, 3)
+	}
+
+	light := color.RGBA{132, 138, 150, 255}
+	dark := color.RGBA{28, 30, 34, 255}
+	return &uikit.Skin{
+		Normal:  slice(color.RGBA{70, 74, 82, 255}, light, dark),
+		Hover:   slice(color.RGBA{86, 92, 102, 255}, light, dark),
+		Pressed: slice(color.RGBA{60, 64, 70, 255}, dark, light),
+	}
 }
 
 func (g *Game) Update() error {
@@ -202,27 +301,13 @@ func (g *Game) Update() error {
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
+	screen.Fill(g.ctx.Theme().BackgroundColor)
 	g.ctx.Draw(screen)
 }
 
 func (g *Game) Layout(outW, outH int) (int, int) {
 	g.initOnce()
 
-	m := ebiten.Monitor()
-	if m == nil {
-		return 0, 0
-	}
-
-	dev := m.DeviceScaleFactor()
-	if dev <= 0 {
-		dev = 1
-	}
-
-	// Optional: make UI a bit larger on small screens.
-	minSide := float64(outW)
-	if float64(outH) < minSide {
-		minSide = float64(outH)
-	}
-
-	return outW, outH
+	// Device pixels, with the theme following the monitor scale (sharp on HiDPI).
+	return g.ctx.Layout(outW, outH)
 }
@@ -52,7 +52,7 @@ func drawErrorText(ctx *Context, dst *ebiten.Image, r image.Rectangle, msg strin
 		return
 	}
 
-	t := ctx.Theme().ErrorText()
+	t := ctx.Theme().ErrorTextFace()
 	t.SetAlign(etxt.Left | etxt.Top)
 	t.Draw(dst, msg, r.Min.X, r.Min.Y)
 }
@@ -35,6 +35,9 @@ const (
 	// interaction (e.g. text changed, checkbox toggled, slider moved, select
 	// changed).
 	EventValueChange
+	// EventLinkClick is fired when a link inside a widget (e.g. RichLabel) is
+	// clicked. The event carries the link target in Text.
+	EventLinkClick
 )
 
 // Event is a UI event routed to a widget.
@@ -43,6 +46,7 @@ type Event struct {
 	Type    EventType
 	Pointer *PointerStatus
 	Key     ebiten.Key
+	Text    string // string payload, e.g. the link target of EventLinkClick
 }
 
 // EventHandler is a function invoked when an event is dispatched.
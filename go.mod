@@ -3,6 +3,7 @@ module github.com/erparts/go-uikit
 go 1.24.0
 
 require (
+	github.com/erparts/go-shapes v0.0.0-20251211181419-8d4b776c77b9
 	github.com/hajimehoshi/ebiten/v2 v2.9.7
 	github.com/tinne26/etxt v0.0.9
 	golang.org/x/image v0.31.0
@@ -12,7 +13,6 @@ require (
 	github.com/ebitengine/gomobile v0.0.0-20250923094054-ea854a63cce1 // indirect
 	github.com/ebitengine/hideconsole v1.0.0 // indirect
 	github.com/ebitengine/purego v0.9.0 // indirect
-	github.com/erparts/go-shapes v0.0.0-20251211181419-8d4b776c77b9 // indirect
 	github.com/jezek/xgb v1.1.1 // indirect
 	golang.org/x/sync v0.17.0 // indirect
 	golang.org/x/sys v0.36.0 // indirect
@@ -0,0 +1,238 @@
+package layout
+
+import (
+	"sort"
+
+	"github.com/erparts/go-uikit"
+	"github.com/erparts/go-uikit/common"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// AnchorPoint is the point of the parent a child is pinned to.
+type AnchorPoint int
+
+const (
+	AnchorTopLeft AnchorPoint = iota
+	AnchorTop
+	AnchorTopRight
+	AnchorLeft
+	AnchorCenter
+	AnchorRight
+	AnchorBottomLeft
+	AnchorBottom
+	AnchorBottomRight
+)
+
+// AnchorItem describes where an Anchor places one of its children.
+type AnchorItem struct {
+	Point AnchorPoint
+
+	// OffsetX/OffsetY move the child inwards from the anchored edges
+	// (positive values move towards the center). On centered axes they are a plain translation.
+	OffsetX int
+	OffsetY int
+
+	// WidthPct is the width as a percentage (0-100] of the parent. When zero, Width
+	// is used; when both are zero the child's intrinsic width, or the full width.
+	WidthPct float64
+	Width    int
+
+	// Z orders overlapping children; higher values are drawn on top and hit first.
+	Z int
+}
+
+// Anchor pins children to points of its frame, e.g. HUD panels in the screen corners.
+// Children overlap freely and are drawn and hit-tested in Z order.
+type Anchor struct {
+	uikit.Base
+	children []uikit.Widget
+	ordered  []uikit.Widget // children sorted back to front
+	items    map[uikit.Widget]AnchorItem
+
+	padX int
+	padY int
+
+	height   uikit.Height
+	contentH int
+}
+
+func NewAnchor(theme *uikit.Theme) *Anchor {
+	l := &Anchor{
+		items: map[uikit.Widget]AnchorItem{},
+	}
+
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	l.Base = uikit.NewBase(cfg)
+	l.Base.HeightCaculator = func() int {
+		if h := l.height.Px(l.Theme()); h > 0 {
+			return h
+		}
+
+		return l.contentH
+	}
+
+	return l
+}
+
+func (l *Anchor) Focusable() bool { return false }
+
+// SetHeight sets the area children are anchored in. Use 0 to fit the tallest child.
+func (l *Anchor) SetHeight(h int) {
+	l.height.Set(h)
+}
+
+func (l *Anchor) SetFrameHeight(h int) {
+	l.height.SetPx(h)
+}
+
+func (l *Anchor) SetPadding(x, y int) {
+	l.padX = x
+	l.padY = y
+}
+
+// SetItem sets the placement of child w.
+func (l *Anchor) SetItem(w uikit.Widget, it AnchorItem) {
+	l.items[w] = it
+	l.sortChildren()
+}
+
+// AddItem appends w with the given placement.
+func (l *Anchor) AddItem(w uikit.Widget, it AnchorItem) {
+	l.children = append(l.children, w)
+	l.SetItem(w, it)
+}
+
+// SetZ changes the z-order of child w.
+func (l *Anchor) SetZ(w uikit.Widget, z int) {
+	it := l.items[w]
+	it.Z = z
+	l.SetItem(w, it)
+}
+
+// Children returns the children back to front. The Context hit-tests its flattened
+// widget list in reverse, so this order is what makes the topmost child win.
+func (l *Anchor) Children() []uikit.Widget {
+	return l.ordered
+}
+
+func (l *Anchor) SetChildren(ws []uikit.Widget) {
+	l.children = ws
+	l.sortChildren()
+}
+
+func (l *Anchor) Add(ws ...uikit.Widget) {
+	l.children = append(l.children, ws...)
+	l.sortChildren()
+}
+
+func (l *Anchor) Clear() {
+	l.children = nil
+	l.ordered = nil
+	l.items = map[uikit.Widget]AnchorItem{}
+}
+
+func (l *Anchor) sortChildren() {
+	l.ordered = append(l.ordered[:0], l.children...)
+	sort.SliceStable(l.ordered, func(i, j int) bool {
+		return l.items[l.ordered[i]].Z < l.items[l.ordered[j]].Z
+	})
+}
+
+func (l *Anchor) Update(ctx *uikit.Context) {
+	l.doLayout(ctx)
+
+	for _, w := range l.ordered {
+		if !w.IsVisible() {
+			continue
+		}
+
+		w.Update(ctx)
+	}
+}
+
+func (l *Anchor) doLayout(ctx *uikit.Context) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+
+	inner := common.Inset(l.Measure(false), padX, padY)
+
+	t := l.Theme()
+	contentH := 0
+	for _, ch := range l.ordered {
+		if !ch.IsVisible() {
+			continue
+		}
+
+		it := l.items[ch]
+		it.OffsetX, it.OffsetY, it.Width = t.Dp(it.OffsetX), t.Dp(it.OffsetY), t.Dp(it.Width)
+
+		w := inner.Dx()
+		switch {
+		case it.WidthPct > 0:
+			w = int(float64(inner.Dx()) * it.WidthPct / 100)
+		case it.Width > 0:
+			w = it.Width
+		default:
+			if iw, ok := ch.(uikit.IntrinsicWidther); ok {
+				w = iw.IntrinsicWidth(ctx)
+			}
+		}
+
+		ch.SetFrame(inner.Min.X, inner.Min.Y, w)
+		h := ch.Measure(true).Dy()
+		contentH = max(contentH, h)
+
+		var x, y int
+		switch it.Point {
+		case AnchorTopLeft, AnchorLeft, AnchorBottomLeft:
+			x = inner.Min.X + it.OffsetX
+		case AnchorTop, AnchorCenter, AnchorBottom:
+			x = inner.Min.X + (inner.Dx()-w)/2 + it.OffsetX
+		default:
+			x = inner.Max.X - w - it.OffsetX
+		}
+
+		switch it.Point {
+		case AnchorTopLeft, AnchorTop, AnchorTopRight:
+			y = inner.Min.Y + it.OffsetY
+		case AnchorLeft, AnchorCenter, AnchorRight:
+			y = inner.Min.Y + (inner.Dy()-h)/2 + it.OffsetY
+		default:
+			y = inner.Max.Y - h - it.OffsetY
+		}
+
+		ch.SetFrame(x, y, w)
+	}
+
+	l.contentH = contentH + padY*2
+}
+
+func (l *Anchor) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.ordered {
+		if !ch.IsVisible() {
+			continue
+		}
+
+		ch.Draw(ctx, dst)
+	}
+}
+
+func (l *Anchor) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.ordered {
+		if ow, ok := any(ch).(uikit.OverlayWidget); ok && ow.OverlayActive() {
+			ow.DrawOverlay(ctx, dst)
+		}
+		if ll, ok := any(ch).(interface {
+			DrawOverlay(*uikit.Context, *ebiten.Image)
+		}); ok {
+			ll.DrawOverlay(ctx, dst)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package layout
+
+import (
+	"github.com/erparts/go-uikit"
+	"github.com/erparts/go-uikit/common"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// DockSide is the edge a Dock child claims.
+type DockSide int
+
+const (
+	DockTop DockSide = iota
+	DockBottom
+	DockLeft
+	DockRight
+	DockFill
+)
+
+// DockItem describes which edge a Dock child claims.
+type DockItem struct {
+	Side DockSide
+
+	// Size is the width for left/right children (zero uses the intrinsic width, or a
+	// quarter of the remaining width) and the height for top/bottom children that
+	// implement uikit.HeightSetter (zero keeps their natural height).
+	Size int
+}
+
+// Dock lets children claim edges in order; the last visible child fills the remainder.
+// This is the classic toolbar + sidebar + status bar + canvas arrangement.
+// Children implementing uikit.HeightSetter (e.g. Stack) get the height of their region,
+// so they scroll when their content doesn't fit.
+type Dock struct {
+	uikit.Base
+	children []uikit.Widget
+	items    map[uikit.Widget]DockItem
+
+	padX int
+	padY int
+	gap  uikit.Gap
+
+	height   uikit.Height
+	contentH int
+}
+
+func NewDock(theme *uikit.Theme) *Dock {
+	l := &Dock{
+		items: map[uikit.Widget]DockItem{},
+	}
+
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	l.Base = uikit.NewBase(cfg)
+	l.Base.HeightCaculator = func() int {
+		if h := l.height.Px(l.Theme()); h > 0 {
+			return h
+		}
+
+		return l.contentH
+	}
+
+	return l
+}
+
+func (l *Dock) Focusable() bool { return false }
+
+// SetHeight sets the docking area height. Use 0 to fit the children.
+func (l *Dock) SetHeight(h int) {
+	l.height.Set(h)
+}
+
+func (l *Dock) SetFrameHeight(h int) {
+	l.height.SetPx(h)
+}
+
+func (l *Dock) SetPadding(x, y int) {
+	l.padX = x
+	l.padY = y
+}
+
+func (l *Dock) SetGap(v int) {
+	l.gap.Set(v)
+}
+
+// SetItem sets the edge claimed by child w.
+func (l *Dock) SetItem(w uikit.Widget, it DockItem) {
+	l.items[w] = it
+}
+
+// AddItem appends w claiming the given edge.
+func (l *Dock) AddItem(w uikit.Widget, it DockItem) {
+	l.Add(w)
+	l.SetItem(w, it)
+}
+
+func (l *Dock) Children() []uikit.Widget {
+	return l.children
+}
+
+func (l *Dock) SetChildren(ws []uikit.Widget) {
+	l.children = ws
+}
+
+func (l *Dock) Add(ws ...uikit.Widget) {
+	l.children = append(l.children, ws...)
+}
+
+func (l *Dock) Clear() {
+	l.children = nil
+	l.items = map[uikit.Widget]DockItem{}
+}
+
+func (l *Dock) Update(ctx *uikit.Context) {
+	l.doLayout(ctx)
+
+	for _, w := range l.children {
+		if !w.IsVisible() {
+			continue
+		}
+
+		w.Update(ctx)
+	}
+}
+
+// setChildHeight hands h to children that accept it. With an unbounded dock (height 0)
+// children keep their natural height unless h is an explicit DockItem.Size.
+func (l *Dock) setChildHeight(w uikit.Widget, h int, explicit bool) {
+	hs, ok := w.(uikit.HeightSetter)
+	if !ok {
+		return
+	}
+
+	if l.height.Px(l.Theme()) == 0 && !explicit {
+		h = 0
+	}
+
+	hs.SetFrameHeight(max(h, 0))
+}
+
+func (l *Dock) doLayout(ctx *uikit.Context) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+	gap := l.gap.Px(l.Theme())
+
+	rem := common.Inset(l.Measure(false), padX, padY)
+
+	visible := make([]uikit.Widget, 0, len(l.children))
+	for _, ch := range l.children {
+		if ch.IsVisible() {
+			visible = append(visible, ch)
+		}
+	}
+
+	edgesH := 0 // top + bottom
+	middleH := 0
+	for i, ch := range visible {
+		it := l.items[ch]
+		it.Size = l.Theme().Dp(it.Size)
+		if i == len(visible)-1 {
+			it.Side = DockFill
+		}
+
+		switch it.Side {
+		case DockTop, DockBottom:
+			if it.Size > 0 {
+				l.setChildHeight(ch, it.Size, true)
+			}
+
+			ch.SetFrame(rem.Min.X, rem.Min.Y, rem.Dx())
+			h := ch.Measure(true).Dy()
+			if it.Side == DockTop {
+				rem.Min.Y += h + gap
+			} else {
+				ch.SetFrame(rem.Min.X, rem.Max.Y-h, rem.Dx())
+				rem.Max.Y -= h + gap
+			}
+			edgesH += h + gap
+
+		case DockLeft, DockRight:
+			w := it.Size
+			if w <= 0 {
+				w = rem.Dx() / 4
+				if iw, ok := ch.(uikit.IntrinsicWidther); ok {
+					w = iw.IntrinsicWidth(ctx)
+				}
+			}
+			w = min(w, max(rem.Dx(), 0))
+
+			l.setChildHeight(ch, rem.Dy(), false)
+
+			x := rem.Min.X
+			if it.Side == DockLeft {
+				rem.Min.X += w + gap
+			} else {
+				x = rem.Max.X - w
+				rem.Max.X -= w + gap
+			}
+
+			ch.SetFrame(x, rem.Min.Y, w)
+			middleH = max(middleH, ch.Measure(true).Dy())
+
+		default:
+			l.setChildHeight(ch, rem.Dy(), false)
+			ch.SetFrame(rem.Min.X, rem.Min.Y, max(rem.Dx(), 0))
+			middleH = max(middleH, ch.Measure(true).Dy())
+
+			// Anything after an explicit fill gets an empty region.
+			rem.Min.X = rem.Max.X
+		}
+	}
+
+	l.contentH = edgesH + middleH + padY*2
+}
+
+func (l *Dock) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.children {
+		if !ch.IsVisible() {
+			continue
+		}
+
+		ch.Draw(ctx, dst)
+	}
+}
+
+func (l *Dock) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.children {
+		if ow, ok := any(ch).(uikit.OverlayWidget); ok && ow.OverlayActive() {
+			ow.DrawOverlay(ctx, dst)
+		}
+		if ll, ok := any(ch).(interface {
+			DrawOverlay(*uikit.Context, *ebiten.Image)
+		}); ok {
+			ll.DrawOverlay(ctx, dst)
+		}
+	}
+}
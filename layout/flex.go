@@ -0,0 +1,494 @@
+package layout
+
+import (
+	"math"
+
+	"github.com/erparts/go-uikit"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Direction is the main axis of a Flex layout.
+type Direction int
+
+const (
+	DirectionRow Direction = iota
+	DirectionColumn
+)
+
+// FlexItem describes how a Flex sizes one of its children.
+// The zero value uses the child's intrinsic width (or an even share of the line), does not
+// grow and shrinks by 1.
+type FlexItem struct {
+	// Grow is the share of the free space given to the child.
+	Grow float64
+	// Shrink is the share of the overflow taken from the child, weighted by its basis.
+	// Zero defaults to 1, negative disables shrinking.
+	Shrink float64
+	// Basis is the initial main size. Zero uses uikit.IntrinsicWidther, or an even share
+	// of the line for children without an intrinsic width.
+	Basis int
+
+	// MinW and MaxW constrain the final width. Zero means unconstrained.
+	MinW int
+	MaxW int
+}
+
+func (it FlexItem) clamp(w int) int {
+	if it.MaxW > 0 && w > it.MaxW {
+		w = it.MaxW
+	}
+	if w < it.MinW {
+		w = it.MinW
+	}
+
+	return max(w, 0)
+}
+
+func (it FlexItem) shrink() float64 {
+	if it.Shrink == 0 {
+		return 1
+	}
+
+	return max(it.Shrink, 0)
+}
+
+// Flex is a flexbox-style layout with per-child grow, shrink and basis.
+//
+// Heights are fixed by the theme, so in DirectionColumn grow/shrink/wrap are ignored:
+// children are stacked vertically and only their widths (cross axis) are resolved.
+type Flex struct {
+	uikit.Base
+	children []uikit.Widget
+	items    map[uikit.Widget]FlexItem
+
+	direction Direction
+	wrap      bool
+	justify   Justify
+	align     Align
+
+	padX int
+	padY int
+	gapX uikit.Gap
+	gapY uikit.Gap
+
+	height   uikit.Height
+	contentH int
+}
+
+func NewFlex(theme *uikit.Theme) *Flex {
+	l := &Flex{
+		items: map[uikit.Widget]FlexItem{},
+	}
+
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	l.Base = uikit.NewBase(cfg)
+	l.Base.HeightCaculator = func() int {
+		if h := l.height.Px(l.Theme()); h > 0 {
+			return h
+		}
+
+		return l.contentH
+	}
+
+	return l
+}
+
+func (l *Flex) Focusable() bool { return false }
+
+// SetHeight sets a fixed height used for column justification. Use 0 to fit the children.
+func (l *Flex) SetHeight(h int) {
+	l.height.Set(h)
+}
+
+func (l *Flex) SetFrameHeight(h int) {
+	l.height.SetPx(h)
+}
+
+func (l *Flex) SetPadding(x, y int) {
+	l.padX = x
+	l.padY = y
+}
+
+func (l *Flex) SetGap(x, y int) {
+	l.gapX.Set(x)
+	l.gapY.Set(y)
+}
+
+func (l *Flex) SetDirection(d Direction) {
+	l.direction = d
+}
+
+// SetWrap lets row children flow onto new lines when they don't fit.
+func (l *Flex) SetWrap(v bool) {
+	l.wrap = v
+}
+
+func (l *Flex) SetJustify(j Justify) {
+	l.justify = j
+}
+
+func (l *Flex) SetAlignItems(a Align) {
+	l.align = a
+}
+
+// SetItem sets the flex properties of child w.
+func (l *Flex) SetItem(w uikit.Widget, it FlexItem) {
+	l.items[w] = it
+}
+
+// AddItem appends w with the given flex properties.
+func (l *Flex) AddItem(w uikit.Widget, it FlexItem) {
+	l.Add(w)
+	l.SetItem(w, it)
+}
+
+func (l *Flex) Children() []uikit.Widget {
+	return l.children
+}
+
+func (l *Flex) SetChildren(ws []uikit.Widget) {
+	l.children = ws
+}
+
+func (l *Flex) Add(ws ...uikit.Widget) {
+	l.children = append(l.children, ws...)
+}
+
+func (l *Flex) Clear() {
+	l.children = nil
+	l.items = map[uikit.Widget]FlexItem{}
+}
+
+// item returns the flex properties of w with its lengths in pixels.
+func (l *Flex) item(w uikit.Widget) FlexItem {
+	it := l.items[w]
+	t := l.Theme()
+	it.Basis, it.MinW, it.MaxW = t.Dp(it.Basis), t.Dp(it.MinW), t.Dp(it.MaxW)
+	return it
+}
+
+// IntrinsicWidth is the width needed to lay out all children on a single line.
+func (l *Flex) IntrinsicWidth(ctx *uikit.Context) int {
+	padX := l.Theme().Dp(l.padX)
+	gapX := l.gapX.Px(l.Theme())
+
+	w := 0
+	n := 0
+	for _, ch := range l.children {
+		if !ch.IsVisible() {
+			continue
+		}
+
+		bw := l.basis(ctx, ch, 0)
+		if l.direction == DirectionColumn {
+			w = max(w, bw)
+		} else {
+			w += bw
+			n++
+		}
+	}
+
+	if n > 1 {
+		w += gapX * (n - 1)
+	}
+
+	return w + padX*2
+}
+
+// basis is the initial main size of w. Children without a Basis or intrinsic width
+// get share, an even part of the line (0 when unknown, e.g. for IntrinsicWidth).
+func (l *Flex) basis(ctx *uikit.Context, w uikit.Widget, share int) int {
+	it := l.item(w)
+
+	b := it.Basis
+	if b <= 0 {
+		b = share
+		if iw, ok := w.(uikit.IntrinsicWidther); ok {
+			b = iw.IntrinsicWidth(ctx)
+		}
+	}
+
+	return it.clamp(b)
+}
+
+func (l *Flex) Update(ctx *uikit.Context) {
+	l.doLayout(ctx)
+
+	for _, w := range l.children {
+		if !w.IsVisible() {
+			continue
+		}
+
+		w.Update(ctx)
+	}
+}
+
+func (l *Flex) doLayout(ctx *uikit.Context) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+	gapX, gapY := l.gapX.Px(l.Theme()), l.gapY.Px(l.Theme())
+
+	visible := make([]uikit.Widget, 0, len(l.children))
+	for _, ch := range l.children {
+		if ch.IsVisible() {
+			visible = append(visible, ch)
+		}
+	}
+
+	if l.direction == DirectionColumn {
+		l.layoutColumn(ctx, visible)
+		return
+	}
+
+	vp := l.Measure(false)
+	innerW := max(vp.Dx()-padX*2, 0)
+
+	share := 0
+	if n := len(visible); n > 0 {
+		share = max(innerW-gapX*(n-1), 0) / n
+	}
+
+	// Break into lines.
+	var lines [][]uikit.Widget
+	var line []uikit.Widget
+	lineW := 0
+	for _, ch := range visible {
+		bw := l.basis(ctx, ch, share)
+		if l.wrap && len(line) > 0 && lineW+gapX+bw > innerW {
+			lines = append(lines, line)
+			line = nil
+			lineW = 0
+		}
+
+		if len(line) > 0 {
+			lineW += gapX
+		}
+		lineW += bw
+		line = append(line, ch)
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	x0 := vp.Min.X + padX
+	y := vp.Min.Y + padY
+	contentH := padY * 2
+
+	for i, line := range lines {
+		lineH := l.layoutLine(ctx, line, x0, y, innerW, share)
+		y += lineH
+		contentH += lineH
+		if i != len(lines)-1 {
+			y += gapY
+			contentH += gapY
+		}
+	}
+
+	l.contentH = contentH
+}
+
+// layoutLine resolves grow/shrink for one line, places it at (x0, y) and returns its height.
+func (l *Flex) layoutLine(ctx *uikit.Context, line []uikit.Widget, x0, y, innerW, share int) int {
+	gapX := l.gapX.Px(l.Theme())
+
+	items := make([]FlexItem, len(line))
+	bases := make([]int, len(line))
+	for i, ch := range line {
+		items[i] = l.item(ch)
+		bases[i] = l.basis(ctx, ch, share)
+	}
+
+	widths := resolveFlex(items, bases, innerW-gapX*(len(line)-1))
+
+	used := gapX * (len(line) - 1)
+	for _, w := range widths {
+		used += w
+	}
+	free := max(innerW-used, 0)
+
+	x := x0
+	spacing := gapX
+	switch l.justify {
+	case JustifyCenter:
+		x += free / 2
+	case JustifyEnd:
+		x += free
+	case JustifySpaceBetween:
+		if len(line) > 1 {
+			spacing += free / (len(line) - 1)
+		}
+	}
+
+	heights := make([]int, len(line))
+	lineH := 0
+	for i, ch := range line {
+		ch.SetFrame(x0, y, widths[i])
+		heights[i] = ch.Measure(true).Dy()
+		lineH = max(lineH, heights[i])
+	}
+
+	for i, ch := range line {
+		cy := y
+		switch l.align {
+		case AlignCenter:
+			cy += (lineH - heights[i]) / 2
+		case AlignEnd:
+			cy += lineH - heights[i]
+		}
+
+		ch.SetFrame(x, cy, widths[i])
+		x += widths[i] + spacing
+	}
+
+	return lineH
+}
+
+// resolveFlex distributes the free space (positive or negative) of avail among the
+// items like CSS flex: items whose MinW/MaxW clamp them are frozen at that size and
+// the rest of the space is distributed again among the others.
+func resolveFlex(items []FlexItem, bases []int, avail int) []int {
+	widths := append([]int(nil), bases...)
+	frozen := make([]bool, len(items))
+
+	for {
+		free := avail
+		totalGrow, totalShrink := 0.0, 0.0
+		for i, it := range items {
+			free -= widths[i]
+			if frozen[i] {
+				continue
+			}
+
+			free += widths[i] - bases[i]
+			totalGrow += max(it.Grow, 0)
+			totalShrink += it.shrink() * float64(bases[i])
+		}
+
+		var weight func(i int) float64
+		switch {
+		case free > 0 && totalGrow > 0:
+			weight = func(i int) float64 { return max(items[i].Grow, 0) / totalGrow }
+		case free < 0 && totalShrink > 0:
+			weight = func(i int) float64 { return items[i].shrink() * float64(bases[i]) / totalShrink }
+		default:
+			return widths
+		}
+
+		// Accumulate before rounding so the line adds up to avail.
+		clamped := false
+		acc, prev := 0.0, 0
+		for i, it := range items {
+			if frozen[i] {
+				continue
+			}
+
+			acc += float64(free) * weight(i)
+			part := int(math.Round(acc)) - prev
+			prev += part
+
+			w := bases[i] + part
+			widths[i] = it.clamp(w)
+			if widths[i] != w {
+				frozen[i] = true
+				clamped = true
+			}
+		}
+
+		if !clamped {
+			return widths
+		}
+	}
+}
+
+func (l *Flex) layoutColumn(ctx *uikit.Context, visible []uikit.Widget) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+	gapY := l.gapY.Px(l.Theme())
+
+	vp := l.Measure(false)
+	x0 := vp.Min.X + padX
+	innerW := max(vp.Dx()-padX*2, 0)
+
+	widths := make([]int, len(visible))
+	heights := make([]int, len(visible))
+	total := 0
+	for i, ch := range visible {
+		it := l.item(ch)
+
+		// Without an explicit basis or intrinsic width, column children fill the cross axis.
+		widths[i] = innerW
+		if _, ok := ch.(uikit.IntrinsicWidther); ok || it.Basis > 0 {
+			widths[i] = min(l.basis(ctx, ch, innerW), innerW)
+		}
+		widths[i] = it.clamp(widths[i])
+
+		ch.SetFrame(x0, vp.Min.Y, widths[i])
+		heights[i] = ch.Measure(true).Dy()
+		total += heights[i]
+	}
+	if len(visible) > 1 {
+		total += gapY * (len(visible) - 1)
+	}
+
+	free := 0
+	if h := l.height.Px(l.Theme()); h > 0 {
+		free = max(h-padY*2-total, 0)
+	}
+
+	y := vp.Min.Y + padY
+	spacing := gapY
+	switch l.justify {
+	case JustifyCenter:
+		y += free / 2
+	case JustifyEnd:
+		y += free
+	case JustifySpaceBetween:
+		if len(visible) > 1 {
+			spacing += free / (len(visible) - 1)
+		}
+	}
+
+	for i, ch := range visible {
+		x := x0
+		switch l.align {
+		case AlignCenter:
+			x += (innerW - widths[i]) / 2
+		case AlignEnd:
+			x += innerW - widths[i]
+		}
+
+		ch.SetFrame(x, y, widths[i])
+		y += heights[i] + spacing
+	}
+
+	l.contentH = total + padY*2
+}
+
+func (l *Flex) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.children {
+		if !ch.IsVisible() {
+			continue
+		}
+
+		ch.Draw(ctx, dst)
+	}
+}
+
+func (l *Flex) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.children {
+		if ow, ok := any(ch).(uikit.OverlayWidget); ok && ow.OverlayActive() {
+			ow.DrawOverlay(ctx, dst)
+		}
+		if ll, ok := any(ch).(interface {
+			DrawOverlay(*uikit.Context, *ebiten.Image)
+		}); ok {
+			ll.DrawOverlay(ctx, dst)
+		}
+	}
+}
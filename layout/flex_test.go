@@ -0,0 +1,81 @@
+package layout
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResolveFlex(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []FlexItem
+		bases []int
+		avail int
+		want  []int
+	}{
+		{
+			name:  "exact fit",
+			items: []FlexItem{{}, {}},
+			bases: []int{100, 100},
+			avail: 200,
+			want:  []int{100, 100},
+		},
+		{
+			name:  "no grow keeps bases",
+			items: []FlexItem{{}, {}},
+			bases: []int{50, 50},
+			avail: 200,
+			want:  []int{50, 50},
+		},
+		{
+			name:  "grow by weight",
+			items: []FlexItem{{Grow: 1}, {Grow: 3}},
+			bases: []int{0, 0},
+			avail: 100,
+			want:  []int{25, 75},
+		},
+		{
+			name:  "grow rounding fills the line",
+			items: []FlexItem{{Grow: 1}, {Grow: 1}, {Grow: 1}},
+			bases: []int{0, 0, 0},
+			avail: 100,
+			want:  []int{33, 34, 33},
+		},
+		{
+			name:  "shrink weighted by basis",
+			items: []FlexItem{{}, {}},
+			bases: []int{100, 300},
+			avail: 200,
+			want:  []int{50, 150},
+		},
+		{
+			name:  "negative shrink disables shrinking",
+			items: []FlexItem{{Shrink: -1}, {}},
+			bases: []int{100, 300},
+			avail: 200,
+			want:  []int{100, 100},
+		},
+		{
+			name:  "max width frees space for the others",
+			items: []FlexItem{{Grow: 1, MaxW: 60}, {Grow: 1}},
+			bases: []int{0, 0},
+			avail: 200,
+			want:  []int{60, 140},
+		},
+		{
+			name:  "min width takes overflow from the others",
+			items: []FlexItem{{MinW: 80}, {}},
+			bases: []int{100, 100},
+			avail: 100,
+			want:  []int{80, 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveFlex(tt.items, tt.bases, tt.avail); !slices.Equal(got, tt.want) {
+				t.Errorf("resolveFlex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
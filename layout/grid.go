@@ -5,32 +5,71 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
-// Grid places children in a fixed column grid. If height > 0 it becomes scrollable and clips via SubImage.
+type trackKind int
+
+const (
+	trackFraction trackKind = iota
+	trackFixed
+	trackAuto
+)
+
+// Track sizes a grid column. Build one with Px, Fr or Auto.
+type Track struct {
+	kind  trackKind
+	value float64
+}
+
+// Px is a fixed-width column.
+func Px(v int) Track { return Track{kind: trackFixed, value: float64(v)} }
+
+// Fr is a column taking a fraction of the space left after Px and Auto columns.
+func Fr(v float64) Track { return Track{kind: trackFraction, value: v} }
+
+// Auto is a column as wide as the widest intrinsic width of its single-column children.
+func Auto() Track { return Track{kind: trackAuto} }
+
+type gridCell struct {
+	row, col         int // -1 means auto-placed
+	rowSpan, colSpan int
+}
+
+// Grid places children in a column grid. If height > 0 it becomes scrollable and clips via SubImage.
+// Columns wider than the frame (fixed tracks or SetContentWidth) scroll horizontally.
+// Columns are equal by default; SetTracks gives per-column sizes. Children can span
+// several cells (SetSpan) and be placed explicitly (SetCell).
 type Grid struct {
 	uikit.Base
 	children []uikit.Widget
 	scroll   uikit.Scroller
-
-	columns int
-	padX    int
-	padY    int
-	gapX    int
-	gapY    int
-	height  int
-	scratch *ebiten.Image
+	cells    map[uikit.Widget]gridCell
+
+	tracks       []Track
+	columns      int
+	padX         int
+	padY         int
+	gapX         uikit.Gap
+	gapY         uikit.Gap
+	height       uikit.Height
+	contentW     int
+	contentH     int
+	contentWidth int
+	scratch      *ebiten.Image
 }
 
 func NewGrid(theme *uikit.Theme) *Grid {
 	l := &Grid{}
+	l.cells = map[uikit.Widget]gridCell{}
 	l.columns = 2
-	l.gapX = theme.SpaceS
-	l.gapY = theme.SpaceS
 	l.scroll = uikit.NewScroller()
 
 	cfg := uikit.NewWidgetBaseConfig(theme)
 	l.Base = uikit.NewBase(cfg)
 	l.Base.HeightCaculator = func() int {
-		return l.height
+		if h := l.height.Px(l.Theme()); h > 0 {
+			return h
+		}
+
+		return l.contentH
 	}
 
 	return l
@@ -38,8 +77,36 @@ func NewGrid(theme *uikit.Theme) *Grid {
 
 func (l *Grid) Focusable() bool { return false }
 
+// SetHeight sets the viewport height. Use 0 to fit the content.
 func (l *Grid) SetHeight(h int) {
-	l.height = h
+	l.height.Set(h)
+}
+
+func (l *Grid) SetFrameHeight(h int) {
+	l.height.SetPx(h)
+}
+
+func (l *Grid) Scroller() *uikit.Scroller {
+	return &l.scroll
+}
+
+// SetContentWidth lays the columns out in w instead of the frame width, so wide
+// grids overflow and scroll horizontally. Use 0 to fit the frame.
+func (l *Grid) SetContentWidth(w int) {
+	l.contentWidth = w
+}
+
+// ScrollTo scrolls the viewport to y, animated if the Scroller is Smooth.
+func (l *Grid) ScrollTo(y int) {
+	l.scroll.ScrollTo(l.scroll.ScrollX, y)
+}
+
+func (l *Grid) ScrollToTop() {
+	l.ScrollTo(0)
+}
+
+func (l *Grid) ScrollToBottom() {
+	l.ScrollTo(l.contentH - l.Measure(false).Dy())
 }
 
 func (l *Grid) SetPadding(x, y int) {
@@ -48,12 +115,40 @@ func (l *Grid) SetPadding(x, y int) {
 }
 
 func (l *Grid) SetGap(x, y int) {
-	l.gapX = x
-	l.gapY = y
+	l.gapX.Set(x)
+	l.gapY.Set(y)
 }
 
+// SetColumns sets the number of equal-width columns, dropping any tracks.
 func (l *Grid) SetColumns(c int) {
 	l.columns = c
+	l.tracks = nil
+}
+
+// SetTracks sets one track per column, e.g. SetTracks(Auto(), Fr(1)) for label/field forms.
+func (l *Grid) SetTracks(tracks ...Track) {
+	l.tracks = tracks
+	l.columns = len(tracks)
+}
+
+// SetSpan makes w span cols columns and rows rows.
+func (l *Grid) SetSpan(w uikit.Widget, cols, rows int) {
+	cell, ok := l.cells[w]
+	if !ok {
+		cell = gridCell{row: -1, col: -1}
+	}
+
+	cell.colSpan = cols
+	cell.rowSpan = rows
+	l.cells[w] = cell
+}
+
+// SetCell places w explicitly at (row, col), zero-based. Negative values restore auto-placement.
+func (l *Grid) SetCell(w uikit.Widget, row, col int) {
+	cell := l.cells[w]
+	cell.row = row
+	cell.col = col
+	l.cells[w] = cell
 }
 
 func (l *Grid) Children() []uikit.Widget {
@@ -70,13 +165,14 @@ func (l *Grid) Add(ws ...uikit.Widget) {
 
 func (l *Grid) Clear() {
 	l.children = nil
+	l.cells = map[uikit.Widget]gridCell{}
 }
 
 func (l *Grid) Update(ctx *uikit.Context) {
 	l.doLayout(ctx)
 
 	if l.Measure(false).Dy() > 0 {
-		l.scroll.Update(ctx, l.Measure(false), l.height)
+		l.scroll.Update(ctx, l.Measure(false), l.contentW, l.contentH)
 		l.doLayout(ctx)
 	}
 
@@ -89,67 +185,236 @@ func (l *Grid) Update(ctx *uikit.Context) {
 }
 
 func (l *Grid) doLayout(ctx *uikit.Context) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+	gapX, gapY := l.gapX.Px(l.Theme()), l.gapY.Px(l.Theme())
+
 	vp := l.Measure(false)
 	cols := l.columns
 	if cols <= 0 {
 		cols = 2
 	}
 
-	innerW := vp.Dx() - l.padX*2
+	innerW := max(vp.Dx(), l.Theme().Dp(l.contentWidth)) - padX*2
 	if innerW < 0 {
 		innerW = 0
 	}
-	cellW := innerW
-	if cols > 0 {
-		cellW = (innerW - (cols-1)*l.gapX) / cols
-		if cellW < 0 {
-			cellW = 0
+
+	visible := make([]uikit.Widget, 0, len(l.children))
+	for _, ch := range l.children {
+		if ch.IsVisible() {
+			visible = append(visible, ch)
 		}
 	}
 
-	x0 := vp.Min.X + l.padX
-	y0 := vp.Min.Y + l.padY
-	x := x0
-	y := y0
+	cells := l.placeCells(visible, cols)
+	colW := l.columnWidths(ctx, visible, cells, cols, innerW)
+
+	x0 := vp.Min.X + padX
+	y0 := vp.Min.Y + padY
 	if vp.Dy() > 0 {
-		y -= l.scroll.ScrollY
+		x0 -= l.scroll.ScrollX
+		y0 -= l.scroll.ScrollY
 	}
 
-	contentH := l.padY * 2
-	rowMaxH := 0
-	col := 0
+	colX := make([]int, cols+1)
+	colX[0] = x0
+	for c := 0; c < cols; c++ {
+		colX[c+1] = colX[c] + colW[c] + gapX
+	}
 
-	for i, ch := range l.children {
-		if !ch.IsVisible() {
+	spanW := func(cell gridCell) int {
+		return colX[cell.col+cell.colSpan] - gapX - colX[cell.col]
+	}
+
+	rows := 0
+	for _, cell := range cells {
+		rows = max(rows, cell.row+cell.rowSpan)
+	}
+
+	// Row heights: single-row cells first, then grow the last spanned row if a
+	// multi-row cell still doesn't fit.
+	heights := make([]int, len(visible))
+	rowH := make([]int, rows)
+	for i, ch := range visible {
+		ch.SetFrame(colX[cells[i].col], y0, spanW(cells[i]))
+		heights[i] = ch.Measure(false).Dy()
+		if cells[i].rowSpan == 1 {
+			rowH[cells[i].row] = max(rowH[cells[i].row], heights[i])
+		}
+	}
+
+	for i, cell := range cells {
+		if cell.rowSpan == 1 {
 			continue
 		}
-		ch.SetFrame(x, y, cellW)
-		r := ch.Measure(false)
-		if r.Dy() > rowMaxH {
-			rowMaxH = r.Dy()
+
+		have := gapY * (cell.rowSpan - 1)
+		for r := cell.row; r < cell.row+cell.rowSpan; r++ {
+			have += rowH[r]
 		}
 
-		col++
-		last := i == len(l.children)-1
-		if col >= cols || last {
-			contentH += rowMaxH
-			if !last {
-				contentH += l.gapY
-			}
-			y += rowMaxH + l.gapY
-			x = x0
-			col = 0
-			rowMaxH = 0
-		} else {
-			x += cellW + l.gapX
+		if need := heights[i] - have; need > 0 {
+			rowH[cell.row+cell.rowSpan-1] += need
 		}
 	}
 
-	if vp.Dy() > 0 && contentH < vp.Dy() {
+	rowY := make([]int, rows+1)
+	rowY[0] = y0
+	for r := 0; r < rows; r++ {
+		rowY[r+1] = rowY[r] + rowH[r] + gapY
+	}
+
+	for i, ch := range visible {
+		ch.SetFrame(colX[cells[i].col], rowY[cells[i].row], spanW(cells[i]))
+	}
+
+	contentH := padY * 2
+	if rows > 0 {
+		contentH += rowY[rows] - gapY - y0
+	}
+
+	if l.height.Px(l.Theme()) > 0 && contentH < vp.Dy() {
 		contentH = vp.Dy()
 	}
 
-	l.SetHeight(contentH)
+	l.contentW = max(colX[cols]-gapX-x0+padX*2, vp.Dx())
+	l.contentH = contentH
+}
+
+// placeCells resolves the cell of each child: explicit placements first, then
+// the rest in order at the first free slot that fits their span.
+func (l *Grid) placeCells(visible []uikit.Widget, cols int) []gridCell {
+	cells := make([]gridCell, len(visible))
+	used := map[[2]int]bool{}
+
+	fits := func(row, col, colSpan, rowSpan int) bool {
+		for r := row; r < row+rowSpan; r++ {
+			for c := col; c < col+colSpan; c++ {
+				if used[[2]int{r, c}] {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+
+	mark := func(cell gridCell) {
+		for r := cell.row; r < cell.row+cell.rowSpan; r++ {
+			for c := cell.col; c < cell.col+cell.colSpan; c++ {
+				used[[2]int{r, c}] = true
+			}
+		}
+	}
+
+	auto := make([]int, 0, len(visible))
+	for i, ch := range visible {
+		cell, ok := l.cells[ch]
+		if !ok {
+			cell = gridCell{row: -1, col: -1}
+		}
+
+		cell.colSpan = clampSpan(cell.colSpan, cols)
+		cell.rowSpan = max(cell.rowSpan, 1)
+		cells[i] = cell
+
+		if cell.row < 0 || cell.col < 0 {
+			auto = append(auto, i)
+			continue
+		}
+
+		cells[i].col = min(cell.col, cols-cell.colSpan)
+		mark(cells[i])
+	}
+
+	row, col := 0, 0
+	for _, i := range auto {
+		cell := cells[i]
+		for {
+			if col+cell.colSpan > cols {
+				row++
+				col = 0
+				continue
+			}
+
+			if fits(row, col, cell.colSpan, cell.rowSpan) {
+				break
+			}
+
+			col++
+		}
+
+		cells[i].row = row
+		cells[i].col = col
+		mark(cells[i])
+		col += cell.colSpan
+	}
+
+	return cells
+}
+
+// columnWidths resolves the track sizes. Without tracks every column is 1fr.
+func (l *Grid) columnWidths(ctx *uikit.Context, visible []uikit.Widget, cells []gridCell, cols, innerW int) []int {
+	gapX := l.gapX.Px(l.Theme())
+
+	widths := make([]int, cols)
+	free := innerW - (cols-1)*gapX
+	totalFr := 0.0
+
+	for c := 0; c < cols; c++ {
+		t := Fr(1)
+		if c < len(l.tracks) {
+			t = l.tracks[c]
+		}
+
+		switch t.kind {
+		case trackFixed:
+			widths[c] = l.Theme().Dp(int(t.value))
+		case trackAuto:
+			for i, ch := range visible {
+				if cells[i].col != c || cells[i].colSpan != 1 {
+					continue
+				}
+				if iw, ok := ch.(uikit.IntrinsicWidther); ok {
+					widths[c] = max(widths[c], iw.IntrinsicWidth(ctx))
+				}
+			}
+		default:
+			totalFr += t.value
+			continue
+		}
+
+		free -= widths[c]
+	}
+
+	if totalFr > 0 && free > 0 {
+		// Round the running total, so the remainder pixels go to the later tracks
+		// and the columns fill innerW exactly.
+		acc, given := 0.0, 0
+		for c := 0; c < cols; c++ {
+			if c < len(l.tracks) && l.tracks[c].kind != trackFraction {
+				continue
+			}
+
+			t := Fr(1)
+			if c < len(l.tracks) {
+				t = l.tracks[c]
+			}
+			acc += float64(free) * t.value / totalFr
+			widths[c] = int(acc+0.5) - given
+			given += widths[c]
+		}
+	}
+
+	return widths
+}
+
+func clampSpan(span, cols int) int {
+	if span < 1 {
+		return 1
+	}
+
+	return min(span, cols)
 }
 
 func (l *Grid) Draw(ctx *uikit.Context, dst *ebiten.Image) {
@@ -190,7 +455,7 @@ func (l *Grid) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 	dst.DrawImage(part, op)
 
 	sub := dst.SubImage(vp).(*ebiten.Image)
-	l.scroll.DrawBar(sub, ctx.Theme(), vp.Dx(), vp.Dy(), l.height)
+	l.scroll.DrawBar(sub, ctx.Theme(), vp.Dx(), vp.Dy(), l.contentW, l.contentH)
 }
 
 func (l *Grid) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
@@ -0,0 +1,101 @@
+package layout
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/erparts/go-uikit"
+)
+
+func TestGridColumnWidths(t *testing.T) {
+	tests := []struct {
+		name   string
+		tracks []Track
+		cols   int
+		gap    int
+		innerW int
+		want   []int
+	}{
+		{name: "equal columns", cols: 3, gap: 10, innerW: 320, want: []int{100, 100, 100}},
+		{name: "remainder spread", cols: 3, innerW: 100, want: []int{33, 34, 33}},
+		{name: "fixed and fractions", tracks: []Track{Px(50), Fr(1), Fr(2)}, gap: 10, innerW: 360, want: []int{50, 97, 193}},
+		{name: "fixed wider than frame", tracks: []Track{Px(200), Fr(1)}, innerW: 150, want: []int{200, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGrid(uikit.DefaultTheme())
+			g.SetGap(tt.gap, tt.gap)
+			g.SetColumns(tt.cols)
+			if tt.tracks != nil {
+				g.SetTracks(tt.tracks...)
+			}
+
+			if got := g.columnWidths(nil, nil, nil, g.columns, tt.innerW); !slices.Equal(got, tt.want) {
+				t.Errorf("columnWidths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGridPlaceCells(t *testing.T) {
+	cell := func(row, col, rowSpan, colSpan int) gridCell {
+		return gridCell{row: row, col: col, rowSpan: rowSpan, colSpan: colSpan}
+	}
+
+	tests := []struct {
+		name  string
+		cols  int
+		setup func(g *Grid, ws []uikit.Widget)
+		want  []gridCell
+	}{
+		{
+			name:  "auto placement",
+			cols:  2,
+			setup: func(g *Grid, ws []uikit.Widget) {},
+			want:  []gridCell{cell(0, 0, 1, 1), cell(0, 1, 1, 1), cell(1, 0, 1, 1), cell(1, 1, 1, 1)},
+		},
+		{
+			name: "explicit cell is skipped by auto placement",
+			cols: 3,
+			setup: func(g *Grid, ws []uikit.Widget) {
+				g.SetSpan(ws[0], 2, 1)
+				g.SetSpan(ws[2], 2, 1)
+				g.SetCell(ws[3], 0, 2)
+			},
+			want: []gridCell{cell(0, 0, 1, 2), cell(1, 0, 1, 1), cell(1, 1, 1, 2), cell(0, 2, 1, 1)},
+		},
+		{
+			name: "row span",
+			cols: 2,
+			setup: func(g *Grid, ws []uikit.Widget) {
+				g.SetSpan(ws[0], 1, 2)
+			},
+			want: []gridCell{cell(0, 0, 2, 1), cell(0, 1, 1, 1), cell(1, 1, 1, 1), cell(2, 0, 1, 1)},
+		},
+		{
+			name: "span clamped to the columns",
+			cols: 2,
+			setup: func(g *Grid, ws []uikit.Widget) {
+				g.SetSpan(ws[1], 5, 1)
+			},
+			want: []gridCell{cell(0, 0, 1, 1), cell(1, 0, 1, 2), cell(2, 0, 1, 1), cell(2, 1, 1, 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			theme := uikit.DefaultTheme()
+			g := NewGrid(theme)
+			ws := make([]uikit.Widget, 4)
+			for i := range ws {
+				ws[i] = NewStack(theme)
+			}
+			tt.setup(g, ws)
+
+			if got := g.placeCells(ws, tt.cols); !slices.Equal(got, tt.want) {
+				t.Errorf("placeCells() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package layout
+
+import (
+	"sort"
+
+	"github.com/erparts/go-uikit"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Breakpoint activates Layout when the frame width is at least MinWidth.
+type Breakpoint struct {
+	MinWidth int
+	Layout   uikit.Layout
+
+	// Apply is called every time the breakpoint becomes active,
+	// e.g. to tune Grid.SetColumns, padding or gap.
+	Apply func()
+}
+
+// Responsive switches between child layouts depending on the width passed to SetFrame.
+// The children are moved into the active layout; focus and scroll position are kept.
+type Responsive struct {
+	uikit.Base
+	children    []uikit.Widget
+	breakpoints []Breakpoint
+	active      int // -1 until the first frame
+
+	height int // logical, see SetHeight
+	frameH int // pixels, see SetFrameHeight
+}
+
+func NewResponsive(theme *uikit.Theme) *Responsive {
+	l := &Responsive{active: -1}
+
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	l.Base = uikit.NewBase(cfg)
+	l.Base.HeightCaculator = func() int {
+		if a := l.Active(); a != nil {
+			return a.Measure(true).Dy()
+		}
+
+		if l.frameH > 0 {
+			return l.frameH
+		}
+
+		return l.Theme().Dp(l.height)
+	}
+
+	return l
+}
+
+func (l *Responsive) Focusable() bool { return false }
+
+// AddBreakpoint registers layout for frame widths >= minWidth. The breakpoint with the
+// lowest minWidth is also used for narrower frames.
+func (l *Responsive) AddBreakpoint(minWidth int, layout uikit.Layout, apply func()) {
+	layout.SetHeight(l.height)
+	layout.SetFrameHeight(l.frameH)
+	l.breakpoints = append(l.breakpoints, Breakpoint{MinWidth: minWidth, Layout: layout, Apply: apply})
+	sort.SliceStable(l.breakpoints, func(i, j int) bool {
+		return l.breakpoints[i].MinWidth < l.breakpoints[j].MinWidth
+	})
+
+	// Indices moved; pick again on the next frame.
+	if a := l.Active(); a != nil {
+		a.SetChildren(nil)
+	}
+	l.active = -1
+}
+
+// Active returns the layout of the current breakpoint, or nil before the first frame.
+func (l *Responsive) Active() uikit.Layout {
+	if l.active < 0 || l.active >= len(l.breakpoints) {
+		return nil
+	}
+
+	return l.breakpoints[l.active].Layout
+}
+
+func (l *Responsive) SetFrame(x, y, w int) {
+	l.selectFor(w)
+	if a := l.Active(); a != nil {
+		a.SetFrame(x, y, w)
+	}
+
+	l.Base.SetFrame(x, y, w)
+}
+
+func (l *Responsive) selectFor(w int) {
+	if len(l.breakpoints) == 0 {
+		return
+	}
+
+	idx := 0
+	for i, bp := range l.breakpoints {
+		if w >= l.Theme().Dp(bp.MinWidth) {
+			idx = i
+		}
+	}
+
+	if idx == l.active {
+		return
+	}
+
+	prev := l.Active()
+	next := l.breakpoints[idx].Layout
+
+	if prev != nil {
+		prev.SetChildren(nil)
+
+		ps, ok1 := prev.(uikit.Scrollable)
+		ns, ok2 := next.(uikit.Scrollable)
+		if ok1 && ok2 {
+			ns.Scroller().ScrollX = ps.Scroller().ScrollX
+			ns.Scroller().ScrollY = ps.Scroller().ScrollY
+		}
+	}
+
+	next.SetChildren(l.children)
+	l.active = idx
+
+	if apply := l.breakpoints[idx].Apply; apply != nil {
+		apply()
+	}
+}
+
+func (l *Responsive) SetHeight(h int) {
+	l.height = h
+	for _, bp := range l.breakpoints {
+		bp.Layout.SetHeight(h)
+	}
+}
+
+func (l *Responsive) SetFrameHeight(h int) {
+	l.frameH = h
+	for _, bp := range l.breakpoints {
+		bp.Layout.SetFrameHeight(h)
+	}
+}
+
+// SetTheme switches the theme of every breakpoint layout, not just the active one.
+func (l *Responsive) SetTheme(t *uikit.Theme) {
+	for _, bp := range l.breakpoints {
+		if ts, ok := bp.Layout.(uikit.ThemeSetter); ok {
+			ts.SetTheme(t)
+		}
+	}
+
+	l.Base.SetTheme(t)
+}
+
+func (l *Responsive) SetPadding(x, y int) {
+	for _, bp := range l.breakpoints {
+		bp.Layout.SetPadding(x, y)
+	}
+}
+
+// Children returns the active layout, so the Context walks into the current arrangement only.
+func (l *Responsive) Children() []uikit.Widget {
+	if a := l.Active(); a != nil {
+		return []uikit.Widget{a}
+	}
+
+	return nil
+}
+
+func (l *Responsive) SetChildren(ws []uikit.Widget) {
+	l.children = ws
+	if a := l.Active(); a != nil {
+		a.SetChildren(ws)
+	}
+}
+
+func (l *Responsive) Add(ws ...uikit.Widget) {
+	l.SetChildren(append(l.children, ws...))
+}
+
+func (l *Responsive) Clear() {
+	l.SetChildren(nil)
+}
+
+func (l *Responsive) Update(ctx *uikit.Context) {
+	if l.active < 0 {
+		r := l.Measure(false)
+		l.SetFrame(r.Min.X, r.Min.Y, r.Dx())
+	}
+
+	if a := l.Active(); a != nil {
+		a.Update(ctx)
+	}
+}
+
+func (l *Responsive) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	if a := l.Active(); a != nil {
+		a.Draw(ctx, dst)
+	}
+}
+
+func (l *Responsive) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	if a := l.Active(); a != nil {
+		a.DrawOverlay(ctx, dst)
+	}
+}
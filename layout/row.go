@@ -0,0 +1,332 @@
+package layout
+
+import (
+	"github.com/erparts/go-uikit"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// SizeMode selects how a child gets its main-axis size.
+type SizeMode int
+
+const (
+	// SizeIntrinsic uses the child's uikit.IntrinsicWidther width; children
+	// without one behave like SizeFill.
+	SizeIntrinsic SizeMode = iota
+	// SizeFixed uses an explicit width.
+	SizeFixed
+	// SizeFill shares the remaining width with other fill children by weight.
+	SizeFill
+)
+
+// Justify controls main-axis distribution of the free space.
+type Justify int
+
+const (
+	JustifyStart Justify = iota
+	JustifyCenter
+	JustifyEnd
+	JustifySpaceBetween
+)
+
+// Align controls cross-axis placement of children with different sizes.
+type Align int
+
+const (
+	AlignStart Align = iota
+	AlignCenter
+	AlignEnd
+)
+
+// RowItem describes how a Row sizes one of its children.
+type RowItem struct {
+	Mode   SizeMode
+	Width  int     // used by SizeFixed
+	Weight float64 // used by SizeFill, defaults to 1
+}
+
+// Row places children horizontally, left to right.
+// Its height is the tallest child plus padding, unless set with SetHeight.
+type Row struct {
+	uikit.Base
+	children []uikit.Widget
+	items    map[uikit.Widget]RowItem
+
+	padX int
+	padY int
+	gap  uikit.Gap
+
+	justify Justify
+	align   Align
+
+	height   uikit.Height
+	contentH int
+}
+
+func NewRow(theme *uikit.Theme) *Row {
+	l := &Row{
+		items: map[uikit.Widget]RowItem{},
+	}
+
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	l.Base = uikit.NewBase(cfg)
+	l.Base.HeightCaculator = func() int {
+		if h := l.height.Px(l.Theme()); h > 0 {
+			return h
+		}
+
+		return l.contentH
+	}
+
+	return l
+}
+
+func (l *Row) Focusable() bool { return false }
+
+// SetHeight sets a fixed row height used for cross-axis alignment. Use 0 to fit the children.
+func (l *Row) SetHeight(h int) {
+	l.height.Set(h)
+}
+
+func (l *Row) SetFrameHeight(h int) {
+	l.height.SetPx(h)
+}
+
+func (l *Row) SetPadding(x, y int) {
+	l.padX = x
+	l.padY = y
+}
+
+func (l *Row) SetGap(v int) {
+	l.gap.Set(v)
+}
+
+func (l *Row) SetJustify(j Justify) {
+	l.justify = j
+}
+
+func (l *Row) SetAlign(a Align) {
+	l.align = a
+}
+
+// SetItem sets the sizing of child w.
+func (l *Row) SetItem(w uikit.Widget, it RowItem) {
+	l.items[w] = it
+}
+
+// AddItem appends w with the given sizing.
+func (l *Row) AddItem(w uikit.Widget, it RowItem) {
+	l.Add(w)
+	l.SetItem(w, it)
+}
+
+// item returns the sizing of w with its width in pixels.
+func (l *Row) item(w uikit.Widget) (RowItem, bool) {
+	it, ok := l.items[w]
+	it.Width = l.Theme().Dp(it.Width)
+	return it, ok
+}
+
+// IntrinsicWidth sums the fixed and intrinsic widths of the children; fill children count as 0.
+func (l *Row) IntrinsicWidth(ctx *uikit.Context) int {
+	padX := l.Theme().Dp(l.padX)
+	gap := l.gap.Px(l.Theme())
+
+	w := 0
+	n := 0
+	for _, ch := range l.children {
+		if !ch.IsVisible() {
+			continue
+		}
+
+		n++
+		it, ok := l.item(ch)
+		switch {
+		case ok && it.Mode == SizeFixed:
+			w += max(it.Width, 0)
+		case !ok || it.Mode == SizeIntrinsic:
+			if iw, ok := ch.(uikit.IntrinsicWidther); ok {
+				w += iw.IntrinsicWidth(ctx)
+			}
+		}
+	}
+
+	if n > 1 {
+		w += gap * (n - 1)
+	}
+
+	return w + padX*2
+}
+
+func (l *Row) Children() []uikit.Widget {
+	return l.children
+}
+
+func (l *Row) SetChildren(ws []uikit.Widget) {
+	l.children = ws
+}
+
+func (l *Row) Add(ws ...uikit.Widget) {
+	l.children = append(l.children, ws...)
+}
+
+func (l *Row) Clear() {
+	l.children = nil
+	l.items = map[uikit.Widget]RowItem{}
+}
+
+func (l *Row) Update(ctx *uikit.Context) {
+	l.doLayout(ctx)
+
+	for _, w := range l.children {
+		if !w.IsVisible() {
+			continue
+		}
+
+		w.Update(ctx)
+	}
+}
+
+func (l *Row) doLayout(ctx *uikit.Context) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+	gap := l.gap.Px(l.Theme())
+
+	vp := l.Measure(false)
+	x0 := vp.Min.X + padX
+	y0 := vp.Min.Y + padY
+	innerW := max(vp.Dx()-padX*2, 0)
+
+	visible := make([]uikit.Widget, 0, len(l.children))
+	for _, ch := range l.children {
+		if ch.IsVisible() {
+			visible = append(visible, ch)
+		}
+	}
+
+	if len(visible) == 0 {
+		l.contentH = padY * 2
+		return
+	}
+
+	widths := make([]int, len(visible))
+	weights := make([]float64, len(visible))
+	used := gap * (len(visible) - 1)
+	totalWeight := 0.0
+
+	for i, ch := range visible {
+		it, ok := l.item(ch)
+		if !ok {
+			it = RowItem{Mode: SizeIntrinsic}
+		}
+
+		switch it.Mode {
+		case SizeFixed:
+			widths[i] = max(it.Width, 0)
+		case SizeIntrinsic:
+			if iw, ok := ch.(uikit.IntrinsicWidther); ok {
+				widths[i] = iw.IntrinsicWidth(ctx)
+				break
+			}
+			fallthrough
+		default:
+			weights[i] = it.Weight
+			if weights[i] <= 0 {
+				weights[i] = 1
+			}
+			totalWeight += weights[i]
+		}
+
+		used += widths[i]
+	}
+
+	free := max(innerW-used, 0)
+	if totalWeight > 0 {
+		given := 0
+		last := -1
+		for i := range visible {
+			if weights[i] == 0 {
+				continue
+			}
+
+			widths[i] = int(float64(free) * weights[i] / totalWeight)
+			given += widths[i]
+			last = i
+		}
+
+		// Rounding leftovers go to the last fill child.
+		widths[last] += free - given
+		free = 0
+	}
+
+	x := x0
+	spacing := gap
+	switch l.justify {
+	case JustifyCenter:
+		x += free / 2
+	case JustifyEnd:
+		x += free
+	case JustifySpaceBetween:
+		if len(visible) > 1 {
+			spacing += free / (len(visible) - 1)
+		}
+	}
+
+	// First pass measures heights (they may depend on width, e.g. error lines).
+	heights := make([]int, len(visible))
+	rowH := 0
+	for i, ch := range visible {
+		ch.SetFrame(x0, y0, widths[i])
+		heights[i] = ch.Measure(true).Dy()
+		rowH = max(rowH, heights[i])
+	}
+
+	lineH := rowH
+	if h := l.height.Px(l.Theme()); h > 0 {
+		lineH = max(h-padY*2, rowH)
+	}
+
+	for i, ch := range visible {
+		y := y0
+		switch l.align {
+		case AlignCenter:
+			y += (lineH - heights[i]) / 2
+		case AlignEnd:
+			y += lineH - heights[i]
+		}
+
+		ch.SetFrame(x, y, widths[i])
+		x += widths[i] + spacing
+	}
+
+	l.contentH = rowH + padY*2
+}
+
+func (l *Row) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.children {
+		if !ch.IsVisible() {
+			continue
+		}
+
+		ch.Draw(ctx, dst)
+	}
+}
+
+func (l *Row) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, ch := range l.children {
+		if ow, ok := any(ch).(uikit.OverlayWidget); ok && ow.OverlayActive() {
+			ow.DrawOverlay(ctx, dst)
+		}
+		if ll, ok := any(ch).(interface {
+			DrawOverlay(*uikit.Context, *ebiten.Image)
+		}); ok {
+			ll.DrawOverlay(ctx, dst)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+package layout
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"github.com/erparts/go-uikit"
+	"github.com/erparts/go-uikit/common"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const splitDoubleClick = 400 * time.Millisecond
+
+// Orientation is the axis a Split divides.
+type Orientation int
+
+const (
+	// Horizontal places the panes side by side.
+	Horizontal Orientation = iota
+	// Vertical places the panes one above the other. It needs a height (SetHeight).
+	Vertical
+)
+
+// Split shows two panes separated by a divider that can be dragged with mouse or touch,
+// or moved with the arrow keys when focused. Double-click (or Enter) collapses the first pane.
+// Each pane is a Stack sized to its region, so overflowing content scrolls.
+// The ratio changes dispatch EventValueChange so it can be persisted.
+type Split struct {
+	uikit.Base
+	panes [2]*Stack
+
+	orientation Orientation
+	ratio       float64
+	minSize     [2]int
+
+	collapsed bool
+
+	// A press on the divider becomes a drag once it moves past Context.DragThreshold,
+	// so the clicks of a double-click don't move it.
+	pressed        bool
+	dragging       bool
+	pressX, pressY int
+	ticks          int
+	lastDown       int // tick of the last press, 0 for none
+
+	padX   int
+	padY   int
+	height uikit.Height
+}
+
+func NewSplit(theme *uikit.Theme, o Orientation) *Split {
+	l := &Split{
+		orientation: o,
+		ratio:       0.5,
+	}
+
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	cfg.DrawSurface = false
+	cfg.DrawBorder = false
+	cfg.DrawFocus = false
+	l.Base = uikit.NewBase(cfg)
+	l.Base.HeightCaculator = func() int {
+		if h := l.height.Px(l.Theme()); h > 0 {
+			return h
+		}
+
+		h := 0
+		for _, p := range l.panes {
+			h = max(h, p.Measure(true).Dy())
+		}
+
+		return h + l.Theme().Dp(l.padY)*2
+	}
+
+	for i := range l.panes {
+		l.panes[i] = NewStack(theme)
+	}
+
+	return l
+}
+
+func (l *Split) Focusable() bool { return true }
+
+// Pane returns the Stack hosting the first (0) or second (1) pane.
+func (l *Split) Pane(i int) *Stack {
+	return l.panes[i]
+}
+
+// SetPanes sets the content of both panes.
+func (l *Split) SetPanes(a, b uikit.Widget) {
+	l.SetChildren([]uikit.Widget{a, b})
+}
+
+// Ratio is the share of the space given to the first pane, in [0, 1].
+func (l *Split) Ratio() float64 {
+	return l.ratio
+}
+
+// SetRatio sets the share of the first pane and dispatches EventValueChange if it changed.
+func (l *Split) SetRatio(r float64) {
+	r = math.Max(0, math.Min(1, r))
+	if r == l.ratio {
+		return
+	}
+
+	l.ratio = r
+	l.Dispatch(uikit.Event{Widget: l, Type: uikit.EventValueChange})
+}
+
+// SetMinSizes sets the minimum size of each pane along the split axis.
+func (l *Split) SetMinSizes(a, b int) {
+	l.minSize = [2]int{max(a, 0), max(b, 0)}
+}
+
+func (l *Split) IsCollapsed() bool { return l.collapsed }
+
+// SetCollapsed hides the first pane and gives all the space to the second one.
+func (l *Split) SetCollapsed(v bool) {
+	if l.collapsed == v {
+		return
+	}
+
+	l.collapsed = v
+	l.panes[0].SetVisible(!v)
+	l.Dispatch(uikit.Event{Widget: l, Type: uikit.EventValueChange})
+}
+
+func (l *Split) SetHeight(h int) {
+	l.height.Set(h)
+}
+
+func (l *Split) SetFrameHeight(h int) {
+	l.height.SetPx(h)
+}
+
+func (l *Split) SetPadding(x, y int) {
+	l.padX = x
+	l.padY = y
+}
+
+func (l *Split) Children() []uikit.Widget {
+	return []uikit.Widget{l.panes[0], l.panes[1]}
+}
+
+// SetChildren puts ws[0] in the first pane and ws[1] in the second; extra widgets are ignored.
+func (l *Split) SetChildren(ws []uikit.Widget) {
+	for i, p := range l.panes {
+		p.Clear()
+		if i < len(ws) && ws[i] != nil {
+			p.Add(ws[i])
+		}
+	}
+}
+
+// Add fills the first empty pane(s).
+func (l *Split) Add(ws ...uikit.Widget) {
+	for _, p := range l.panes {
+		if len(ws) == 0 {
+			return
+		}
+
+		if len(p.Children()) == 0 {
+			p.Add(ws[0])
+			ws = ws[1:]
+		}
+	}
+}
+
+func (l *Split) Clear() {
+	for _, p := range l.panes {
+		p.Clear()
+	}
+}
+
+func (l *Split) dividerSize() int {
+	return l.Theme().SpaceS
+}
+
+// sizes returns the padded area, its length along the split axis minus the divider
+// and the resolved size of the first pane.
+func (l *Split) sizes() (image.Rectangle, int, int) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+
+	inner := common.Inset(l.Measure(false), padX, padY)
+
+	total := inner.Dx()
+	if l.orientation == Vertical {
+		total = inner.Dy()
+	}
+	total = max(total-l.dividerSize(), 0)
+
+	if l.collapsed {
+		return inner, total, 0
+	}
+
+	a := l.clampSize(int(math.Round(float64(total)*l.ratio)), total)
+	return inner, total, a
+}
+
+func (l *Split) dividerRect() image.Rectangle {
+	inner, _, a := l.sizes()
+	d := l.dividerSize()
+
+	if l.orientation == Vertical {
+		return image.Rect(inner.Min.X, inner.Min.Y+a, inner.Max.X, inner.Min.Y+a+d)
+	}
+
+	return image.Rect(inner.Min.X+a, inner.Min.Y, inner.Min.X+a+d, inner.Max.Y)
+}
+
+func (l *Split) doLayout() {
+	inner, total, a := l.sizes()
+	d := l.dividerSize()
+	b := total - a
+
+	first, second := l.panes[0], l.panes[1]
+	if l.orientation == Vertical {
+		first.SetFrameHeight(a)
+		first.SetFrame(inner.Min.X, inner.Min.Y, inner.Dx())
+		second.SetFrameHeight(b)
+		second.SetFrame(inner.Min.X, inner.Min.Y+a+d, inner.Dx())
+		return
+	}
+
+	// Unbounded horizontal splits let the panes take their natural height.
+	h := 0
+	if l.height.Px(l.Theme()) > 0 {
+		h = inner.Dy()
+	}
+
+	first.SetFrameHeight(h)
+	first.SetFrame(inner.Min.X, inner.Min.Y, a)
+	second.SetFrameHeight(h)
+	second.SetFrame(inner.Min.X+a+d, inner.Min.Y, b)
+}
+
+func (l *Split) Update(ctx *uikit.Context) {
+	l.doLayout()
+	l.handleInput(ctx)
+	l.doLayout()
+
+	for _, p := range l.panes {
+		if !p.IsVisible() {
+			continue
+		}
+
+		p.Update(ctx)
+	}
+}
+
+func (l *Split) handleInput(ctx *uikit.Context) {
+	if !l.IsEnabled() {
+		l.pressed = false
+		l.dragging = false
+		return
+	}
+
+	ptr := ctx.Pointer()
+	inner, total, a := l.sizes()
+
+	// Slightly larger grab area, mostly for touch.
+	grab := l.dividerRect().Inset(-l.Theme().SpaceS / 2)
+
+	l.ticks++
+	if ptr.IsJustDown && common.Contains(grab, ptr.X, ptr.Y) {
+		if l.lastDown > 0 && l.ticks-l.lastDown < uikit.DurationTicks(splitDoubleClick) {
+			l.SetCollapsed(!l.collapsed)
+			l.lastDown = 0
+			l.pressed = false
+			return
+		}
+
+		l.lastDown = l.ticks
+		l.pressed = true
+		l.pressX, l.pressY = ptr.X, ptr.Y
+		ctx.SetFocus(l)
+	}
+
+	if l.pressed && !l.dragging && ptr.IsDown {
+		dx, dy := ptr.X-l.pressX, ptr.Y-l.pressY
+		if th := ctx.Dp(ctx.DragThreshold); dx*dx+dy*dy > th*th {
+			l.dragging = true
+		}
+	}
+
+	if l.dragging && ptr.IsDown && total > 0 {
+		pos := ptr.X - inner.Min.X
+		if l.orientation == Vertical {
+			pos = ptr.Y - inner.Min.Y
+		}
+
+		l.SetCollapsed(false)
+		l.setSize(pos-l.dividerSize()/2, total)
+	}
+
+	if ptr.IsJustUp || !ptr.IsDown {
+		l.pressed = false
+		l.dragging = false
+	}
+
+	if !l.IsFocused() || total <= 0 {
+		return
+	}
+
+	step := l.Theme().ControlH
+	dec, inc := ebiten.KeyArrowLeft, ebiten.KeyArrowRight
+	if l.orientation == Vertical {
+		dec, inc = ebiten.KeyArrowUp, ebiten.KeyArrowDown
+	}
+
+	switch {
+	case inpututil.IsKeyJustPressed(dec):
+		l.SetCollapsed(false)
+		l.setSize(a-step, total)
+	case inpututil.IsKeyJustPressed(inc):
+		l.SetCollapsed(false)
+		l.setSize(a+step, total)
+	case inpututil.IsKeyJustPressed(ebiten.KeyHome):
+		l.SetCollapsed(false)
+		l.setSize(0, total)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnd):
+		l.SetCollapsed(false)
+		l.setSize(total, total)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		l.SetCollapsed(!l.collapsed)
+	}
+}
+
+// setSize sets the first pane size in pixels, honoring the min sizes.
+func (l *Split) setSize(a, total int) {
+	l.SetRatio(float64(l.clampSize(a, total)) / float64(total))
+}
+
+// clampSize keeps the first pane size a within the min sizes, when both fit in total.
+func (l *Split) clampSize(a, total int) int {
+	minA, minB := l.Theme().Dp(l.minSize[0]), l.Theme().Dp(l.minSize[1])
+	if minA+minB <= total {
+		a = max(a, minA)
+		a = min(a, total-minB)
+	}
+
+	return a
+}
+
+func (l *Split) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, p := range l.panes {
+		if !p.IsVisible() {
+			continue
+		}
+
+		p.Draw(ctx, dst)
+	}
+
+	theme := ctx.Theme()
+	div := l.dividerRect()
+
+	col := theme.BorderColor
+	if l.dragging || l.IsFocused() {
+		col = theme.FocusColor
+	} else if l.IsHovered() {
+		col = theme.SurfaceHoverColor
+	}
+
+	// Thin line centered in the divider area.
+	bw := max(theme.BorderW, 1)
+	line := div
+	if l.orientation == Vertical {
+		cy := div.Min.Y + div.Dy()/2
+		line = image.Rect(div.Min.X, cy-bw/2, div.Max.X, cy-bw/2+bw)
+	} else {
+		cx := div.Min.X + div.Dx()/2
+		line = image.Rect(cx-bw/2, div.Min.Y, cx-bw/2+bw, div.Max.Y)
+	}
+
+	if l.IsHovered() || l.dragging || l.IsFocused() {
+		line = div
+	}
+
+	l.DrawRoundedRect(dst, line, 0, col)
+}
+
+func (l *Split) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if !l.IsVisible() {
+		return
+	}
+
+	for _, p := range l.panes {
+		p.DrawOverlay(ctx, dst)
+	}
+}
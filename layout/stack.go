@@ -8,18 +8,21 @@ import (
 )
 
 // Stack places children vertically. If height > 0 it becomes scrollable and clips via SubImage.
+// With a content width wider than the frame (SetContentWidth) it also scrolls horizontally.
 type Stack struct {
 	uikit.Base
 	children []uikit.Widget
 
 	padX int
 	padY int
-	gap  int
+	gap  uikit.Gap
 
 	Scroll uikit.Scroller
 
-	height   int
-	contentH int
+	height       uikit.Height
+	contentW     int
+	contentH     int
+	contentWidth int
 
 	scratch    *ebiten.Image
 	background color.RGBA
@@ -32,14 +35,13 @@ func NewStack(theme *uikit.Theme) *Stack {
 	l.Base = uikit.NewBase(cfg)
 	l.Base.SetEnabled(true)
 	l.Base.HeightCaculator = func() int {
-		if l.height == 0 {
-			return l.contentH
+		if h := l.height.Px(l.Theme()); h > 0 {
+			return h
 		}
 
-		return l.height
+		return l.contentH
 	}
 
-	l.gap = theme.SpaceS
 	l.Scroll = uikit.NewScroller()
 	return l
 }
@@ -48,7 +50,34 @@ func (l *Stack) Focusable() bool { return false }
 
 // SetHeight sets the viewport height. Use 0 for unlimited (no scroll, no clipping).
 func (l *Stack) SetHeight(h int) {
-	l.height = h
+	l.height.Set(h)
+}
+
+func (l *Stack) SetFrameHeight(h int) {
+	l.height.SetPx(h)
+}
+
+func (l *Stack) Scroller() *uikit.Scroller {
+	return &l.Scroll
+}
+
+// SetContentWidth lays the children out in w instead of the frame width, so wide
+// content (tables, long rows) overflows and scrolls horizontally. Use 0 to fit the frame.
+func (l *Stack) SetContentWidth(w int) {
+	l.contentWidth = w
+}
+
+// ScrollTo scrolls the viewport to y, animated if the Scroller is Smooth.
+func (l *Stack) ScrollTo(y int) {
+	l.Scroll.ScrollTo(l.Scroll.ScrollX, y)
+}
+
+func (l *Stack) ScrollToTop() {
+	l.ScrollTo(0)
+}
+
+func (l *Stack) ScrollToBottom() {
+	l.ScrollTo(l.contentH - l.Measure(false).Dy())
 }
 
 func (l *Stack) SetPadding(x, y int) {
@@ -57,7 +86,7 @@ func (l *Stack) SetPadding(x, y int) {
 }
 
 func (l *Stack) SeGap(v int) {
-	l.gap = v
+	l.gap.Set(v)
 }
 
 func (l *Stack) Children() []uikit.Widget {
@@ -81,7 +110,7 @@ func (l *Stack) Update(ctx *uikit.Context) {
 
 	// Scroll input only when height is limited
 	if r.Dy() > 0 {
-		l.Scroll.Update(ctx, r, l.contentH)
+		l.Scroll.Update(ctx, r, l.contentW, l.contentH)
 		l.doLayout(ctx)
 	}
 
@@ -95,20 +124,25 @@ func (l *Stack) Update(ctx *uikit.Context) {
 }
 
 func (l *Stack) doLayout(ctx *uikit.Context) {
+	padX, padY := l.Theme().Dp(l.padX), l.Theme().Dp(l.padY)
+	gap := l.gap.Px(l.Theme())
+
 	vp := l.Measure(false)
-	x0 := vp.Min.X + l.padX
-	y0 := vp.Min.Y + l.padY
-	w0 := vp.Dx() - l.padX*2
+	x0 := vp.Min.X + padX
+	y0 := vp.Min.Y + padY
+	contentW := max(vp.Dx(), l.Theme().Dp(l.contentWidth))
+	w0 := contentW - padX*2
 	if w0 < 0 {
 		w0 = 0
 	}
 
 	y := y0
 	if vp.Dy() > 0 {
+		x0 -= l.Scroll.ScrollX
 		y -= l.Scroll.ScrollY
 	}
 
-	contentH := l.padY * 2
+	contentH := padY * 2
 	for i, ch := range l.children {
 		if !ch.IsVisible() {
 			continue
@@ -118,16 +152,17 @@ func (l *Stack) doLayout(ctx *uikit.Context) {
 		r := ch.Measure(true)
 		contentH += r.Dy()
 		if i != len(l.children)-1 {
-			contentH += l.gap
+			contentH += gap
 		}
-		y += r.Dy() + l.gap
+		y += r.Dy() + gap
 	}
 
 	// At least viewport height so scrollbar math is stable
-	if vp.Dy() > 0 && contentH < vp.Dy() {
+	if l.height.Px(l.Theme()) > 0 && contentH < vp.Dy() {
 		contentH = vp.Dy()
 	}
 
+	l.contentW = contentW
 	l.contentH = contentH
 }
 
@@ -175,7 +210,7 @@ func (l *Stack) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 
 	sub := dst.SubImage(vp).(*ebiten.Image)
 
-	l.Scroll.DrawBar(sub, ctx.Theme(), vp.Dx(), vp.Dy(), l.contentH)
+	l.Scroll.DrawBar(sub, ctx.Theme(), vp.Dx(), vp.Dy(), l.contentW, l.contentH)
 }
 
 func (l *Stack) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
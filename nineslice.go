@@ -0,0 +1,99 @@
+package uikit
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// NineSlice is an image split in a 3x3 grid by its insets: corners are drawn unscaled,
+// edges are stretched along one axis and the center along both.
+type NineSlice struct {
+	Image *ebiten.Image
+
+	// Insets of the fixed borders, in source pixels.
+	Left, Top, Right, Bottom int
+}
+
+func NewNineSlice(img *ebiten.Image, left, top, right, bottom int) *NineSlice {
+	return &NineSlice{Image: img, Left: left, Top: top, Right: right, Bottom: bottom}
+}
+
+// Draw fills r with the nine-slice. The borders are multiplied by scale (e.g. Theme.Scale)
+// and shrunk when r is smaller than them.
+func (n *NineSlice) Draw(dst *ebiten.Image, r image.Rectangle, scale float64) {
+	if n == nil || n.Image == nil || r.Dx() <= 0 || r.Dy() <= 0 {
+		return
+	}
+
+	if scale <= 0 {
+		scale = 1
+	}
+
+	b := n.Image.Bounds()
+	sx := [4]int{b.Min.X, b.Min.X + n.Left, b.Max.X - n.Right, b.Max.X}
+	sy := [4]int{b.Min.Y, b.Min.Y + n.Top, b.Max.Y - n.Bottom, b.Max.Y}
+
+	l, t := float64(n.Left)*scale, float64(n.Top)*scale
+	rt, bt := float64(n.Right)*scale, float64(n.Bottom)*scale
+	if k := float64(r.Dx()) / (l + rt); k < 1 {
+		l, rt = l*k, rt*k
+	}
+	if k := float64(r.Dy()) / (t + bt); k < 1 {
+		t, bt = t*k, bt*k
+	}
+
+	// Whole pixels, so neighbouring slices don't leave seams.
+	dx := [4]float64{float64(r.Min.X), math.Round(float64(r.Min.X) + l), math.Round(float64(r.Max.X) - rt), float64(r.Max.X)}
+	dy := [4]float64{float64(r.Min.Y), math.Round(float64(r.Min.Y) + t), math.Round(float64(r.Max.Y) - bt), float64(r.Max.Y)}
+
+	for j := 0; j < 3; j++ {
+		for i := 0; i < 3; i++ {
+			src := image.Rect(sx[i], sy[j], sx[i+1], sy[j+1])
+			w, h := dx[i+1]-dx[i], dy[j+1]-dy[j]
+			if src.Empty() || w <= 0 || h <= 0 {
+				continue
+			}
+
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(w/float64(src.Dx()), h/float64(src.Dy()))
+			op.GeoM.Translate(dx[i], dy[j])
+			op.Filter = ebiten.FilterLinear
+			dst.DrawImage(n.Image.SubImage(src).(*ebiten.Image), op)
+		}
+	}
+}
+
+// Skin holds one nine-slice per widget state. A Skin on a Style replaces the rounded
+// surface, border and (while Focused is shown) focus ring; text and padding still come
+// from the Style and Theme. Nil states fall back to Normal.
+type Skin struct {
+	Normal   *NineSlice
+	Hover    *NineSlice
+	Pressed  *NineSlice
+	Disabled *NineSlice
+	Focused  *NineSlice
+}
+
+// Get returns the nine-slice for state s. Disabled, pressed and hover win over focused.
+func (k *Skin) Get(s WidgetState, focused bool) *NineSlice {
+	var n *NineSlice
+	switch s {
+	case StateDisabled:
+		n = k.Disabled
+	case StatePressed:
+		n = k.Pressed
+	case StateHover:
+		n = k.Hover
+	}
+
+	if n == nil && focused {
+		n = k.Focused
+	}
+	if n == nil {
+		n = k.Normal
+	}
+
+	return n
+}
@@ -20,13 +20,61 @@ const (
 	ScrollbarOnMove
 )
 
-// Scroller is a small helper that manages vertical scrolling and a simple scrollbar.
+const (
+	// DefaultScrollFriction is the default Scroller.Friction.
+	DefaultScrollFriction = 4.0
+
+	scrollMinVelocity = 20.0 // px/s below which a fling stops
+	scrollSnapRate    = 14.0 // 1/s, how fast an overscroll snaps back
+	scrollAnimRate    = 12.0 // 1/s, how fast ScrollTo eases when Smooth
+	scrollMaxStretch  = 0.25 // overscroll limit as a fraction of the viewport
+)
+
+// DefaultDragThreshold is the default Context.DragThreshold, in logical units.
+const DefaultDragThreshold = 8
+
+// scrollGrab is the scrollbar thumb being dragged.
+type scrollGrab int
+
+const (
+	grabNone scrollGrab = iota
+	grabVertical
+	grabHorizontal
+)
+
+// Scroller is a small helper that manages scrolling on both axes and simple scrollbars.
 // It is intentionally simple and relies on clipping via SubImage when drawing.
+//
+// A press only becomes a drag once it moves past Context.DragThreshold; the pressed
+// child is then released without a click. Scrollbar thumbs can be dragged and a press
+// on the track pages towards the pointer.
+//
+// Releasing a drag keeps scrolling with the release velocity until Friction stops it.
+// With Overscroll the content can be pulled past its edges and snaps back when released.
+// Motion is computed in seconds, so it behaves the same at any TPS.
 type Scroller struct {
+	ScrollX int
 	ScrollY int
 
+	// Friction is the velocity decay rate per second after a fling.
+	// Higher values stop sooner; 0 disables inertia.
+	Friction float64
+
+	// Overscroll enables rubber-band stretching at the edges.
+	Overscroll bool
+
+	// Smooth animates programmatic scrolls (ScrollTo, Context.ScrollIntoView).
+	Smooth bool
+
+	x, y scrollAxis
+
 	// Drag state
+	pressed  bool // pointer went down inside, may still become a drag
 	dragging bool
+	grab     scrollGrab
+	startPX  int
+	startPY  int
+	lastPX   int
 	lastPY   int
 
 	// For ScrollbarOnMove
@@ -35,61 +83,207 @@ type Scroller struct {
 	Scrollbar ScrollbarMode
 }
 
+// scrollAxis is the sub-pixel position and velocity (px/s) of one axis.
+type scrollAxis struct {
+	pos float64
+	vel float64
+
+	target    float64
+	animating bool
+}
+
 func NewScroller() Scroller {
 	return Scroller{
 		Scrollbar: ScrollbarOnMove,
+		Friction:  DefaultScrollFriction,
 	}
 }
 
-func (s *Scroller) IsScrolling() bool { return s.dragging || s.showTicks > 0 }
+func (s *Scroller) IsScrolling() bool {
+	return s.dragging || s.grab != grabNone || s.showTicks > 0 ||
+		s.x.vel != 0 || s.y.vel != 0 || s.x.animating || s.y.animating
+}
+
+// Stop cancels any fling or animation in progress.
+func (s *Scroller) Stop() {
+	s.x.vel, s.x.animating = 0, false
+	s.y.vel, s.y.animating = 0, false
+}
+
+// ScrollTo scrolls to (x, y), animated if Smooth is set. The position is clamped on the next Update.
+func (s *Scroller) ScrollTo(x, y int) {
+	s.Stop()
+	if !s.Smooth {
+		s.ScrollX, s.ScrollY = x, y
+		s.x.pos, s.y.pos = float64(x), float64(y)
+		return
+	}
+
+	s.x.target, s.x.animating = float64(x), true
+	s.y.target, s.y.animating = float64(y), true
+}
 
 // Update updates scrolling using wheel + drag/touch, only if the pointer is inside viewport.
-// contentH is the full scrollable content height in pixels.
-func (s *Scroller) Update(ctx *Context, viewport image.Rectangle, contentH int) {
+// contentW/contentH are the full scrollable content size in pixels. Shift+wheel scrolls horizontally.
+func (s *Scroller) Update(ctx *Context, viewport image.Rectangle, contentW, contentH int) {
 	if viewport.Dx() <= 0 || viewport.Dy() <= 0 {
 		return
 	}
 
+	// ScrollX/ScrollY may have been set from outside since the last frame.
+	s.x.sync(s.ScrollX)
+	s.y.sync(s.ScrollY)
+
+	maxX := float64(max(contentW-viewport.Dx(), 0))
+	maxY := float64(max(contentH-viewport.Dy(), 0))
+	stretchX := float64(viewport.Dx()) * scrollMaxStretch
+	stretchY := float64(viewport.Dy()) * scrollMaxStretch
+	if !s.Overscroll {
+		stretchX, stretchY = 0, 0
+	}
+
+	dt := frameSeconds()
+	prevX, prevY := s.ScrollX, s.ScrollY
+
 	ptr := ctx.Pointer()
 	inside := common.Contains(viewport, ptr.X, ptr.Y)
 
-	changed := false
+	// Wheel (desktop). Trackpads report wx directly; Shift turns a vertical wheel horizontal.
+	wx, wy := ebiten.Wheel()
+	if ebiten.IsKeyPressed(ebiten.KeyShift) && wx == 0 {
+		wx, wy = wy, 0
+	}
 
-	// Wheel (desktop)
-	_, wy := ebiten.Wheel()
-	if wy != 0 && inside {
+	if (wx != 0 || wy != 0) && inside {
 		step := int(math.Round(float64(ctx.Theme().ControlH) * 0.65))
 		if step < 10 {
 			step = 10
 		}
-		s.ScrollY -= int(math.Round(wy * float64(step)))
-		changed = true
+
+		s.Stop()
+		s.x.pos = math.Max(0, math.Min(maxX, s.x.pos-wx*float64(step)))
+		s.y.pos = math.Max(0, math.Min(maxY, s.y.pos-wy*float64(step)))
+	}
+
+	vTrack, vThumb, hTrack, hThumb := s.bars(viewport, ctx.Theme(), contentW, contentH)
+
+	// Bars are interactive while shown; the grab area is wider than the drawn bar, for touch.
+	var vHit, hHit image.Rectangle
+	if s.Scrollbar == ScrollbarAlways || (s.Scrollbar == ScrollbarOnMove && s.IsScrolling()) {
+		grabW := max(ctx.Theme().SpaceS, vTrack.Dx(), hTrack.Dy())
+		if !vTrack.Empty() {
+			vHit = image.Rect(vTrack.Max.X-grabW, vTrack.Min.Y, vTrack.Max.X, vTrack.Max.Y)
+		}
+		if !hTrack.Empty() {
+			hHit = image.Rect(hTrack.Min.X, hTrack.Max.Y-grabW, hTrack.Max.X, hTrack.Max.Y)
+		}
 	}
 
-	// Drag (mouse/touch)
 	if ptr.IsJustDown && inside {
-		s.dragging = true
-		s.lastPY = ptr.Y
-		s.showTicks = 18
+		flinging := s.x.vel != 0 || s.y.vel != 0
+		s.Stop()
+		s.startPX, s.startPY = ptr.X, ptr.Y
+		s.lastPX, s.lastPY = ptr.X, ptr.Y
+
+		switch {
+		case common.Contains(vHit, ptr.X, ptr.Y):
+			if ptr.Y >= vThumb.Min.Y && ptr.Y < vThumb.Max.Y {
+				s.grab = grabVertical
+			} else if ptr.Y < vThumb.Min.Y {
+				s.y.pos = math.Max(0, s.y.pos-float64(viewport.Dy()))
+			} else {
+				s.y.pos = math.Min(maxY, s.y.pos+float64(viewport.Dy()))
+			}
+			s.consumePress(ctx)
+
+		case common.Contains(hHit, ptr.X, ptr.Y):
+			if ptr.X >= hThumb.Min.X && ptr.X < hThumb.Max.X {
+				s.grab = grabHorizontal
+			} else if ptr.X < hThumb.Min.X {
+				s.x.pos = math.Max(0, s.x.pos-float64(viewport.Dx()))
+			} else {
+				s.x.pos = math.Min(maxX, s.x.pos+float64(viewport.Dx()))
+			}
+			s.consumePress(ctx)
+
+		default:
+			s.pressed = true
+
+			// A tap that stops a fling shouldn't also click what's under it.
+			if flinging {
+				s.consumePress(ctx)
+			}
+		}
 	}
 
-	if s.dragging && ptr.IsJustDown {
-		dy := ptr.Y - s.lastPY
-		s.ScrollY -= dy
-		s.lastPY = ptr.Y
-		if dy != 0 {
-			changed = true
+	if ptr.IsDown {
+		switch s.grab {
+		case grabVertical:
+			s.y.pos += float64(ptr.Y-s.lastPY) * thumbRatio(vTrack.Dy(), vThumb.Dy(), maxY)
+			s.y.pos = math.Max(0, math.Min(maxY, s.y.pos))
+		case grabHorizontal:
+			s.x.pos += float64(ptr.X-s.lastPX) * thumbRatio(hTrack.Dx(), hThumb.Dx(), maxX)
+			s.x.pos = math.Max(0, math.Min(maxX, s.x.pos))
 		}
 	}
 
-	if ptr.IsJustUp {
+	// Drag (mouse/touch), once the press has moved far enough along an axis we can scroll.
+	if s.pressed && !s.dragging && ptr.IsDown {
+		dx, dy := ptr.X-s.startPX, ptr.Y-s.startPY
+		if max(abs(dx), abs(dy)) >= ctx.Dp(ctx.DragThreshold) {
+			canScroll := maxY > 0
+			if abs(dx) > abs(dy) {
+				canScroll = maxX > 0
+			}
+
+			if canScroll && ctx.scrollGesture == nil {
+				ctx.scrollGesture = s
+				s.dragging = true
+				s.lastPX, s.lastPY = ptr.X, ptr.Y
+				s.showTicks = 18
+				ctx.CancelPress()
+			} else {
+				// Another scroller (or none) owns this gesture.
+				s.pressed = false
+			}
+		}
+	}
+
+	if s.dragging && ptr.IsDown {
+		s.x.drag(float64(s.lastPX-ptr.X), maxX, stretchX, dt)
+		s.y.drag(float64(s.lastPY-ptr.Y), maxY, stretchY, dt)
+	}
+
+	s.lastPX, s.lastPY = ptr.X, ptr.Y
+
+	if ptr.IsJustUp || !ptr.IsDown {
+		if ctx.scrollGesture == s {
+			ctx.scrollGesture = nil
+		}
+
+		s.pressed = false
 		s.dragging = false
+		s.grab = grabNone
+	}
+
+	if !s.dragging {
+		s.x.animate(maxX, dt)
+		s.y.animate(maxY, dt)
+		s.x.fling(maxX, s.Friction, dt, s.Overscroll)
+		s.y.fling(maxY, s.Friction, dt, s.Overscroll)
 	}
 
-	s.Clamp(viewport.Dy(), contentH)
+	s.x.pos = math.Max(-stretchX, math.Min(maxX+stretchX, s.x.pos))
+	s.y.pos = math.Max(-stretchY, math.Min(maxY+stretchY, s.y.pos))
+	s.ScrollX = int(math.Round(s.x.pos))
+	s.ScrollY = int(math.Round(s.y.pos))
 
+	changed := s.ScrollX != prevX || s.ScrollY != prevY
 	if s.Scrollbar == ScrollbarOnMove {
-		if changed {
+		// Hovering a shown bar keeps it visible so it can be grabbed.
+		hoverBar := !ptr.IsTouch && (common.Contains(vHit, ptr.X, ptr.Y) || common.Contains(hHit, ptr.X, ptr.Y))
+
+		if changed || s.grab != grabNone || hoverBar {
 			s.showTicks = 18
 		} else if s.showTicks > 0 {
 			s.showTicks--
@@ -97,28 +291,112 @@ func (s *Scroller) Update(ctx *Context, viewport image.Rectangle, contentH int)
 	}
 }
 
-// Clamp clamps ScrollY to the valid range for the given viewport height and content height.
-func (s *Scroller) Clamp(viewportH int, contentH int) {
-	max := contentH - viewportH
-	if max < 0 {
-		max = 0
+// consumePress keeps a press meant for the scrollbar from reaching the widgets below.
+func (s *Scroller) consumePress(ctx *Context) {
+	ctx.ConsumePress()
+	s.showTicks = 18
+}
+
+// thumbRatio is the content distance scrolled per pixel of thumb movement.
+func thumbRatio(trackL, thumbL int, maxScroll float64) float64 {
+	if trackL-thumbL <= 0 {
+		return 0
+	}
+
+	return maxScroll / float64(trackL-thumbL)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// sync resets the axis when its integer position was changed from outside.
+func (a *scrollAxis) sync(v int) {
+	if int(math.Round(a.pos)) != v {
+		*a = scrollAxis{pos: float64(v)}
 	}
-	if s.ScrollY < 0 {
-		s.ScrollY = 0
+}
+
+// animate eases the axis towards its ScrollTo target.
+func (a *scrollAxis) animate(maxPos, dt float64) {
+	if !a.animating {
+		return
 	}
-	if s.ScrollY > max {
-		s.ScrollY = max
+
+	target := math.Max(0, math.Min(maxPos, a.target))
+	a.pos += (target - a.pos) * (1 - math.Exp(-scrollAnimRate*dt))
+	if math.Abs(target-a.pos) < 0.5 {
+		a.pos = target
+		a.animating = false
 	}
 }
 
-// DrawBar draws a simple vertical scrollbar inside a clipped target (dst should already be a SubImage of the viewport).
-// viewportW/H should match dst's size.
-func (s *Scroller) DrawBar(dst *ebiten.Image, theme *Theme, viewportW, viewportH, contentH int) {
-	if viewportW <= 0 || viewportH <= 0 {
+// drag moves the axis by delta pixels, resisting past the edges, and tracks the velocity.
+func (a *scrollAxis) drag(delta, maxPos, stretch, dt float64) {
+	if stretch > 0 && (a.pos < 0 || a.pos > maxPos) {
+		delta *= 0.5
+	}
+
+	before := a.pos
+	a.pos += delta
+	if stretch == 0 {
+		a.pos = math.Max(0, math.Min(maxPos, a.pos))
+	}
+
+	// Smoothed over ~50ms, so a single jittery frame doesn't decide the fling.
+	k := 1 - math.Exp(-dt/0.05)
+	a.vel += ((a.pos-before)/dt - a.vel) * k
+}
+
+// fling advances an inertial scroll and snaps back an overscroll.
+func (a *scrollAxis) fling(maxPos, friction, dt float64, overscroll bool) {
+	if friction <= 0 {
+		a.vel = 0
+	}
+
+	if a.vel != 0 {
+		a.pos += a.vel * dt
+		a.vel *= math.Exp(-friction * dt)
+		if math.Abs(a.vel) < scrollMinVelocity {
+			a.vel = 0
+		}
+	}
+
+	edge := math.Max(0, math.Min(maxPos, a.pos))
+	if a.pos == edge {
+		return
+	}
+
+	if !overscroll {
+		a.pos = edge
+		a.vel = 0
 		return
 	}
 
-	if contentH <= viewportH {
+	// Past an edge: brake hard and pull back towards it.
+	a.vel *= math.Exp(-scrollSnapRate * 2 * dt)
+	a.pos = edge + (a.pos-edge)*math.Exp(-scrollSnapRate*dt)
+	if math.Abs(a.pos-edge) < 0.5 {
+		a.pos = edge
+		a.vel = 0
+	}
+}
+
+// Clamp clamps ScrollX/ScrollY to the valid range for the given viewport and content size.
+func (s *Scroller) Clamp(viewportW, viewportH, contentW, contentH int) {
+	s.ScrollX = clampInt(s.ScrollX, 0, max(contentW-viewportW, 0))
+	s.ScrollY = clampInt(s.ScrollY, 0, max(contentH-viewportH, 0))
+}
+
+// DrawBar draws simple scrollbars inside a clipped target (dst should already be a SubImage of the viewport):
+// a vertical one when contentH overflows and a horizontal one when contentW does.
+// viewportW/H should match dst's size.
+func (s *Scroller) DrawBar(dst *ebiten.Image, theme *Theme, viewportW, viewportH, contentW, contentH int) {
+	if viewportW <= 0 || viewportH <= 0 {
 		return
 	}
 
@@ -136,21 +414,65 @@ func (s *Scroller) DrawBar(dst *ebiten.Image, theme *Theme, viewportW, viewportH
 		return
 	}
 
+	vp := image.Rect(0, 0, viewportW, viewportH).Add(dst.Bounds().Min)
+	vTrack, vThumb, hTrack, hThumb := s.bars(vp, theme, contentW, contentH)
+
+	for _, r := range []image.Rectangle{vTrack, hTrack} {
+		if !r.Empty() {
+			vector.DrawFilledRect(dst, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), theme.BorderColor, false)
+		}
+	}
+
+	for _, r := range []image.Rectangle{vThumb, hThumb} {
+		if !r.Empty() {
+			vector.DrawFilledRect(dst, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), theme.FocusColor, false)
+		}
+	}
+}
+
+// bars returns the vertical and horizontal track and thumb rectangles for viewport.
+// A bar is empty when its axis doesn't overflow.
+func (s *Scroller) bars(viewport image.Rectangle, theme *Theme, contentW, contentH int) (vTrack, vThumb, hTrack, hThumb image.Rectangle) {
+	viewportW, viewportH := viewport.Dx(), viewport.Dy()
 	trackW := int(math.Max(3, float64(theme.BorderW)))
-	trackX := viewportW - trackW
-	trackH := viewportH
 
-	thumbH := int(math.Max(12, float64(trackH)*float64(trackH)/float64(contentH)))
-	maxScroll := contentH - trackH
+	// Leave the corner free when both bars are shown.
+	corner := 0
+	if contentW > viewportW && contentH > viewportH {
+		corner = trackW
+	}
 
-	thumbY := 0
-	if maxScroll > 0 {
-		thumbY = int(math.Round(float64(trackH-thumbH) * float64(s.ScrollY) / float64(maxScroll)))
+	if contentH > viewportH {
+		trackL := viewportH - corner
+		pos, l := scrollThumb(trackL, viewportH, contentH, s.ScrollY)
+
+		x := viewport.Max.X - trackW
+		vTrack = image.Rect(x, viewport.Min.Y, viewport.Max.X, viewport.Min.Y+trackL)
+		vThumb = image.Rect(x, viewport.Min.Y+pos, viewport.Max.X, viewport.Min.Y+pos+l)
 	}
 
-	ox := dst.Bounds().Min.X
-	oy := dst.Bounds().Min.Y
+	if contentW > viewportW {
+		trackL := viewportW - corner
+		pos, l := scrollThumb(trackL, viewportW, contentW, s.ScrollX)
+
+		y := viewport.Max.Y - trackW
+		hTrack = image.Rect(viewport.Min.X, y, viewport.Min.X+trackL, viewport.Max.Y)
+		hThumb = image.Rect(viewport.Min.X+pos, y, viewport.Min.X+pos+l, viewport.Max.Y)
+	}
+
+	return vTrack, vThumb, hTrack, hThumb
+}
+
+// scrollThumb returns the offset and length of a scrollbar thumb along a track.
+func scrollThumb(trackL, viewportL, contentL, scroll int) (int, int) {
+	thumbL := int(math.Max(12, float64(trackL)*float64(viewportL)/float64(contentL)))
+	thumbL = min(thumbL, trackL)
+	maxScroll := contentL - viewportL
+
+	pos := 0
+	if maxScroll > 0 {
+		pos = int(math.Round(float64(trackL-thumbL) * float64(scroll) / float64(maxScroll)))
+	}
 
-	vector.DrawFilledRect(dst, float32(trackX+ox), float32(oy), float32(trackW), float32(trackH), theme.BorderColor, false)
-	vector.DrawFilledRect(dst, float32(trackX+ox), float32(oy+thumbY), float32(trackW), float32(thumbH), theme.FocusColor, false)
+	return pos, thumbL
 }
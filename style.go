@@ -0,0 +1,212 @@
+package uikit
+
+import (
+	"image/color"
+	"math"
+)
+
+// WidgetState is the interaction state a Style picks its colors for.
+type WidgetState int
+
+const (
+	StateNormal WidgetState = iota
+	StateHover
+	StatePressed
+	StateDisabled
+)
+
+// StateColors holds one color per state. Zero (fully transparent) colors are unset:
+// Hover, Pressed and Disabled fall back to Normal.
+type StateColors struct {
+	Normal   color.RGBA
+	Hover    color.RGBA
+	Pressed  color.RGBA
+	Disabled color.RGBA
+}
+
+// Get returns the color for s.
+func (c StateColors) Get(s WidgetState) color.RGBA {
+	var col color.RGBA
+	switch s {
+	case StateHover:
+		col = c.Hover
+	case StatePressed:
+		col = c.Pressed
+	case StateDisabled:
+		col = c.Disabled
+	}
+
+	if col == (color.RGBA{}) {
+		return c.Normal
+	}
+
+	return col
+}
+
+func (c StateColors) merge(o StateColors) StateColors {
+	pick := func(a, b color.RGBA) color.RGBA {
+		if b != (color.RGBA{}) {
+			return b
+		}
+		return a
+	}
+
+	return StateColors{
+		Normal:   pick(c.Normal, o.Normal),
+		Hover:    pick(c.Hover, o.Hover),
+		Pressed:  pick(c.Pressed, o.Pressed),
+		Disabled: pick(c.Disabled, o.Disabled),
+	}
+}
+
+// Style overrides how a widget looks. It can be attached to a widget (Base.SetStyle)
+// or registered as a named class on the Theme (Theme.Classes, Base.SetClass).
+// Zero fields inherit: widget style over class over the theme defaults.
+type Style struct {
+	Surface StateColors
+	Border  StateColors
+	Text    StateColors
+	Focus   color.RGBA // focus ring
+
+	// Skin replaces the rounded surface and border with nine-slice images.
+	Skin *Skin
+
+	Face    Face // zero is FaceRegular
+	Radius  int  // negative for square corners
+	BorderW int
+	FontPx  int
+	PadX    int
+	PadY    int
+
+	// SurfaceOnHover only draws the surface while hovered or pressed (ghost buttons).
+	SurfaceOnHover bool
+	// HideBorder skips the border.
+	HideBorder bool
+}
+
+func (s Style) merge(o Style) Style {
+	s.Surface = s.Surface.merge(o.Surface)
+	s.Border = s.Border.merge(o.Border)
+	s.Text = s.Text.merge(o.Text)
+
+	if o.Focus != (color.RGBA{}) {
+		s.Focus = o.Focus
+	}
+	if o.Skin != nil {
+		s.Skin = o.Skin
+	}
+	if o.Face != FaceRegular {
+		s.Face = o.Face
+	}
+	if o.Radius != 0 {
+		s.Radius = o.Radius
+	}
+	if o.BorderW != 0 {
+		s.BorderW = o.BorderW
+	}
+	if o.FontPx != 0 {
+		s.FontPx = o.FontPx
+	}
+	if o.PadX != 0 {
+		s.PadX = o.PadX
+	}
+	if o.PadY != 0 {
+		s.PadY = o.PadY
+	}
+
+	s.SurfaceOnHover = s.SurfaceOnHover || o.SurfaceOnHover
+	s.HideBorder = s.HideBorder || o.HideBorder
+	return s
+}
+
+// DefaultStyle is the style every widget starts from, built from the theme colors and sizes.
+func (t *Theme) DefaultStyle() Style {
+	return Style{
+		Surface: StateColors{
+			Normal:   t.SurfaceColor,
+			Hover:    t.SurfaceHoverColor,
+			Pressed:  t.SurfacePressedColor,
+			Disabled: t.SurfacePressedColor,
+		},
+		Border: StateColors{
+			Normal:   t.BorderColor,
+			Disabled: t.DisabledColor,
+		},
+		Text: StateColors{
+			Normal:   t.TextColor,
+			Disabled: t.DisabledColor,
+		},
+		Focus: t.FocusColor,
+
+		Radius:  t.Radius,
+		BorderW: t.BorderW,
+		FontPx:  t.FontPx,
+		PadX:    t.PadX,
+		PadY:    t.PadY,
+	}
+}
+
+// Class returns the style registered as name in Classes, or the built-in class:
+// "button", "label", "primary", "secondary", "danger", "ghost" and "emphasis".
+// Built-in classes follow the theme colors, so they work with every palette.
+func (t *Theme) Class(name string) (Style, bool) {
+	if s, ok := t.Classes[name]; ok {
+		return s, true
+	}
+
+	return t.builtinClass(name)
+}
+
+func (t *Theme) builtinClass(name string) (Style, bool) {
+	filled := func(c color.RGBA) Style {
+		text := color.RGBA{255, 255, 255, 255}
+		if luminance(c) > 0.6 {
+			text = color.RGBA{0, 0, 0, 255}
+		}
+
+		return Style{
+			Surface: StateColors{
+				Normal:  c,
+				Hover:   mixColor(c, color.RGBA{255, 255, 255, 255}, 0.15),
+				Pressed: mixColor(c, color.RGBA{0, 0, 0, 255}, 0.20),
+			},
+			Border: StateColors{Normal: c},
+			Text:   StateColors{Normal: text},
+		}
+	}
+
+	switch name {
+	case "button":
+		return Style{Surface: StateColors{Hover: t.BorderColor, Pressed: t.FocusColor}}, true
+	case "label":
+		return Style{Text: StateColors{Normal: t.MutedTextColor}}, true
+	case "primary":
+		return filled(t.FocusColor), true
+	case "danger":
+		return filled(t.ErrorBorderColor), true
+	case "secondary":
+		return Style{
+			Surface: StateColors{Normal: t.BackgroundColor, Hover: t.SurfaceHoverColor, Pressed: t.SurfacePressedColor},
+			Border:  StateColors{Normal: t.FocusColor},
+			Text:    StateColors{Normal: t.FocusColor},
+		}, true
+	case "ghost":
+		return Style{
+			Surface:        StateColors{Hover: t.SurfaceHoverColor, Pressed: t.SurfacePressedColor},
+			SurfaceOnHover: true,
+			HideBorder:     true,
+		}, true
+	case "emphasis":
+		return Style{
+			Text:   StateColors{Normal: t.TextColor},
+			Face:   FaceBold,
+			FontPx: int(math.Round(float64(t.FontPx) * 1.15)),
+		}, true
+	}
+
+	return Style{}, false
+}
+
+func luminance(c color.RGBA) float64 {
+	return (0.2126*float64(c.R) + 0.7152*float64(c.G) + 0.0722*float64(c.B)) / 255
+}
@@ -0,0 +1,155 @@
+package uikit
+
+import (
+	"image/color"
+	"testing"
+)
+
+var (
+	red  = color.RGBA{255, 0, 0, 255}
+	blue = color.RGBA{0, 0, 255, 255}
+)
+
+func TestStyleMerge(t *testing.T) {
+	base := Style{
+		Text:    StateColors{Normal: red, Hover: red},
+		Radius:  4,
+		FontPx:  20,
+		PadX:    8,
+		Face:    FaceBold,
+		BorderW: 1,
+	}
+
+	got := base.merge(Style{
+		Text:       StateColors{Hover: blue},
+		Radius:     -1,
+		PadX:       2,
+		HideBorder: true,
+	})
+
+	want := Style{
+		Text:       StateColors{Normal: red, Hover: blue},
+		Radius:     -1,
+		FontPx:     20,
+		PadX:       2,
+		Face:       FaceBold,
+		BorderW:    1,
+		HideBorder: true,
+	}
+	if got != want {
+		t.Errorf("merge() = %+v, want %+v", got, want)
+	}
+
+	if got := base.merge(Style{}); got != base {
+		t.Errorf("merging a zero Style changed %+v into %+v", base, got)
+	}
+}
+
+func TestStateColorsGet(t *testing.T) {
+	c := StateColors{Normal: red, Pressed: blue}
+
+	tests := []struct {
+		state WidgetState
+		want  color.RGBA
+	}{
+		{StateNormal, red},
+		{StateHover, red},
+		{StatePressed, blue},
+		{StateDisabled, red},
+	}
+
+	for _, tt := range tests {
+		if got := c.Get(tt.state); got != tt.want {
+			t.Errorf("Get(%d) = %v, want %v", tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestResolveStyle(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(th *Theme, b *Base)
+		check func(t *testing.T, th *Theme, st Style)
+	}{
+		{
+			name:  "theme defaults",
+			setup: func(th *Theme, b *Base) {},
+			check: func(t *testing.T, th *Theme, st Style) {
+				if st != th.DefaultStyle() {
+					t.Errorf("got %+v, want DefaultStyle", st)
+				}
+			},
+		},
+		{
+			name:  "built-in class",
+			setup: func(th *Theme, b *Base) { b.SetClass("label") },
+			check: func(t *testing.T, th *Theme, st Style) {
+				if st.Text.Normal != th.MutedTextColor {
+					t.Errorf("Text = %v, want MutedTextColor", st.Text.Normal)
+				}
+			},
+		},
+		{
+			name: "text style over built-in class",
+			setup: func(th *Theme, b *Base) {
+				b.SetClass("label")
+				b.SetTextStyle(TextH1)
+			},
+			check: func(t *testing.T, th *Theme, st Style) {
+				tf := th.Typeface(TextH1)
+				if st.Text.Normal != th.TextColor || st.FontPx != tf.FontPx || st.Face != tf.Face {
+					t.Errorf("Text %v, FontPx %d, Face %v; want TextColor, %d, %v", st.Text.Normal, st.FontPx, st.Face, tf.FontPx, tf.Face)
+				}
+			},
+		},
+		{
+			name: "theme class over text style",
+			setup: func(th *Theme, b *Base) {
+				th.Classes = map[string]Style{"label": {Text: StateColors{Normal: red}}}
+				b.SetClass("label")
+				b.SetTextStyle(TextCaption)
+			},
+			check: func(t *testing.T, th *Theme, st Style) {
+				if st.Text.Normal != red || st.FontPx != th.Typeface(TextCaption).FontPx {
+					t.Errorf("Text %v, FontPx %d; want the class color and caption size", st.Text.Normal, st.FontPx)
+				}
+			},
+		},
+		{
+			name: "theme class replaces the built-in class",
+			setup: func(th *Theme, b *Base) {
+				th.Classes = map[string]Style{"primary": {Radius: 3}}
+				b.SetClass("primary")
+			},
+			check: func(t *testing.T, th *Theme, st Style) {
+				builtin, _ := th.builtinClass("primary")
+				if st.Radius != 3 || st.Surface.Normal != builtin.Surface.Normal {
+					t.Errorf("Radius %d, Surface %v; want 3 over the built-in surface", st.Radius, st.Surface.Normal)
+				}
+			},
+		},
+		{
+			name: "widget style over everything",
+			setup: func(th *Theme, b *Base) {
+				th.Classes = map[string]Style{"x": {Text: StateColors{Normal: red}, FontPx: 30}}
+				b.SetClass("x")
+				b.SetTextStyle(TextH2)
+				b.SetStyle(Style{Text: StateColors{Normal: blue}})
+			},
+			check: func(t *testing.T, th *Theme, st Style) {
+				if st.Text.Normal != blue || st.FontPx != 30 {
+					t.Errorf("Text %v, FontPx %d; want the widget color and class size", st.Text.Normal, st.FontPx)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := DefaultTheme()
+			b := NewBase(NewWidgetBaseConfig(th))
+			tt.setup(th, &b)
+			tt.check(t, th, b.ResolveStyle(th))
+		})
+	}
+}
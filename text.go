@@ -0,0 +1,289 @@
+package uikit
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tinne26/etxt"
+	"github.com/tinne26/etxt/fract"
+	"golang.org/x/image/font/sfnt"
+)
+
+// Face names a font style of the Theme.
+type Face int
+
+const (
+	FaceRegular Face = iota
+	FaceBold
+	FaceItalic
+	FaceMono
+)
+
+// TextRenderer is an etxt.Renderer with per-glyph font fallback: runes missing from
+// the renderer font are drawn and measured with the first fallback font that has them.
+// Text without missing runes takes the plain etxt path.
+type TextRenderer struct {
+	*etxt.Renderer
+
+	fallbacks []*sfnt.Font
+	coverage  map[*sfnt.Font]map[rune]bool
+	buf       sfnt.Buffer
+}
+
+func newTextRenderer(f *sfnt.Font, fallbacks []*sfnt.Font) *TextRenderer {
+	r := etxt.NewRenderer()
+	r.Utils().SetCache8MiB()
+	r.Glyph().SetMissHandler(etxt.OnMissNotdef)
+	r.SetFont(f)
+
+	return &TextRenderer{
+		Renderer:  r,
+		fallbacks: fallbacks,
+		coverage:  map[*sfnt.Font]map[rune]bool{},
+	}
+}
+
+// textRun is a piece of a line drawn with a single font.
+type textRun struct {
+	font *sfnt.Font
+	text string
+}
+
+func (r *TextRenderer) has(f *sfnt.Font, c rune) bool {
+	m, ok := r.coverage[f]
+	if !ok {
+		m = map[rune]bool{}
+		r.coverage[f] = m
+	}
+
+	v, ok := m[c]
+	if !ok {
+		idx, err := f.GlyphIndex(&r.buf, c)
+		v = err == nil && idx != 0
+		m[c] = v
+	}
+
+	return v
+}
+
+// fontFor returns the font used to draw c: the renderer font if it has the glyph,
+// otherwise the first fallback with it, otherwise the renderer font (drawing notdef).
+func (r *TextRenderer) fontFor(c rune) *sfnt.Font {
+	primary := r.GetFont()
+	if c == '\n' || c == ' ' || r.has(primary, c) {
+		return primary
+	}
+
+	for _, f := range r.fallbacks {
+		if f != primary && r.has(f, c) {
+			return f
+		}
+	}
+
+	return primary
+}
+
+// runs splits a single line into same-font runs. ok is false when it's all primary.
+func (r *TextRenderer) runs(line string) ([]textRun, bool) {
+	primary := r.GetFont()
+
+	var runs []textRun
+	start := 0
+	var cur *sfnt.Font
+	for i, c := range line {
+		f := r.fontFor(c)
+		if cur == nil {
+			cur = f
+		}
+
+		if f != cur {
+			runs = append(runs, textRun{font: cur, text: line[start:i]})
+			start = i
+			cur = f
+		}
+	}
+
+	if cur == nil {
+		return nil, false
+	}
+
+	runs = append(runs, textRun{font: cur, text: line[start:]})
+	return runs, len(runs) > 1 || runs[0].font != primary
+}
+
+func (r *TextRenderer) needsFallback(text string) bool {
+	if len(r.fallbacks) == 0 {
+		return false
+	}
+
+	primary := r.GetFont()
+	for _, c := range text {
+		if r.fontFor(c) != primary {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lineWidth measures a line run by run.
+func (r *TextRenderer) lineWidth(line string) fract.Unit {
+	runs, ok := r.runs(line)
+	if !ok {
+		return r.Renderer.Measure(line).Width()
+	}
+
+	primary := r.GetFont()
+	defer r.SetFont(primary)
+
+	var w fract.Unit
+	for _, run := range runs {
+		r.SetFont(run.font)
+		w += r.Renderer.Measure(run.text).Width()
+	}
+
+	return w
+}
+
+// Measure is etxt.Renderer.Measure with font fallback.
+func (r *TextRenderer) Measure(text string) fract.Rect {
+	rect := r.Renderer.Measure(text)
+	if !r.needsFallback(text) {
+		return rect
+	}
+
+	var w fract.Unit
+	for _, line := range strings.Split(text, "\n") {
+		w = max(w, r.lineWidth(line))
+	}
+
+	return fract.UnitsToRect(0, 0, w, rect.Height())
+}
+
+// Draw is etxt.Renderer.Draw with font fallback.
+func (r *TextRenderer) Draw(target etxt.Target, text string, x, y int) {
+	if !r.needsFallback(text) {
+		r.Renderer.Draw(target, text, x, y)
+		return
+	}
+
+	align := r.GetAlign()
+	primary := r.GetFont()
+	defer func() {
+		r.SetFont(primary)
+		r.SetAlign(align)
+	}()
+
+	lines := strings.Split(text, "\n")
+	lineH := int(r.Utils().GetLineHeight())
+
+	// Place the block as a whole, then each line on its own.
+	switch align.Vert() {
+	case etxt.VertCenter:
+		y -= (len(lines) - 1) * lineH / 2
+	case etxt.Bottom, etxt.Baseline, etxt.LastBaseline:
+		y -= (len(lines) - 1) * lineH
+	}
+
+	for i, line := range lines {
+		w := r.lineWidth(line).ToIntCeil()
+		lx := x
+		switch align.Horz() {
+		case etxt.HorzCenter:
+			lx -= w / 2
+		case etxt.Right:
+			lx -= w
+		}
+
+		r.SetAlign(etxt.Left)
+		runs, _ := r.runs(line)
+		for _, run := range runs {
+			r.SetFont(run.font)
+			r.Renderer.Draw(target, run.text, lx, y+i*lineH)
+			lx += r.Renderer.Measure(run.text).IntWidth()
+		}
+		r.SetAlign(align)
+	}
+}
+
+// TextLine is a line of wrapped text: text[Start:End].
+type TextLine struct {
+	Start, End int
+}
+
+// Wrap breaks text into lines no wider than width, at spaces when possible and
+// anywhere in words longer than a line. Explicit newlines are kept. The spaces lines
+// break at are left out. A width <= 0 only splits on newlines.
+func (r *TextRenderer) Wrap(text string, width int) []TextLine {
+	var lines []TextLine
+
+	start := 0
+	for {
+		end := strings.IndexByte(text[start:], '\n')
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += start
+		}
+
+		if width <= 0 {
+			lines = append(lines, TextLine{start, end})
+		} else {
+			lines = r.wrapParagraph(lines, text, start, end, width)
+		}
+
+		if end == len(text) {
+			return lines
+		}
+
+		start = end + 1
+	}
+}
+
+func (r *TextRenderer) wrapParagraph(lines []TextLine, text string, start, end, width int) []TextLine {
+	lineStart, lastBreak := start, -1
+	for i := start; i < end; {
+		c, size := utf8.DecodeRuneInString(text[i:])
+		if c == ' ' {
+			lastBreak = i
+		}
+
+		if i > lineStart && r.lineWidth(text[lineStart:i+size]).ToIntCeil() > width {
+			if lastBreak > lineStart {
+				lines = append(lines, TextLine{lineStart, lastBreak})
+				lineStart = lastBreak + 1
+				i = max(i, lineStart)
+			} else {
+				lines = append(lines, TextLine{lineStart, i})
+				lineStart = i
+			}
+
+			lastBreak = -1
+			continue
+		}
+
+		i += size
+	}
+
+	return append(lines, TextLine{lineStart, end})
+}
+
+// Ellipsize cuts line and ends it with an ellipsis so it fits width.
+// Lines that already fit are returned as is.
+func (r *TextRenderer) Ellipsize(line string, width int) string {
+	if r.lineWidth(line).ToIntCeil() <= width {
+		return line
+	}
+
+	for cut := len(line); cut > 0; {
+		_, size := utf8.DecodeLastRuneInString(line[:cut])
+		cut -= size
+
+		s := strings.TrimRight(line[:cut], " ") + "…"
+		if r.lineWidth(s).ToIntCeil() <= width {
+			return s
+		}
+	}
+
+	return "…"
+}
@@ -0,0 +1,70 @@
+package uikit
+
+import (
+	"slices"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTextRendererWrap(t *testing.T) {
+	r := DefaultTheme().TextFace(FaceRegular)
+	w := func(s string) int { return r.lineWidth(s).ToIntCeil() }
+
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		want  []string
+	}{
+		{name: "empty", text: "", width: 100, want: []string{""}},
+		{name: "no width splits newlines", text: "hello world\nfoo", width: 0, want: []string{"hello world", "foo"}},
+		{name: "fits", text: "hello world", width: w("hello world"), want: []string{"hello world"}},
+		{name: "breaks at spaces", text: "hello world foo", width: w("hello world"), want: []string{"hello world", "foo"}},
+		{name: "drops the break space", text: "hello world", width: max(w("hello"), w("world")), want: []string{"hello", "world"}},
+		{name: "splits long words", text: "mmmmmmmm", width: w("mmm"), want: []string{"mmm", "mmm", "mm"}},
+		{name: "keeps empty lines", text: "a\n\nb", width: 100, want: []string{"a", "", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, l := range r.Wrap(tt.text, tt.width) {
+				got = append(got, tt.text[l.Start:l.End])
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Wrap(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextRendererEllipsize(t *testing.T) {
+	r := DefaultTheme().TextFace(FaceRegular)
+	w := func(s string) int { return r.lineWidth(s).ToIntCeil() }
+
+	tests := []struct {
+		name  string
+		line  string
+		width int
+		want  string
+	}{
+		{name: "fits", line: "hello world", width: w("hello world"), want: "hello world"},
+		{name: "cut", line: "hello world", width: w("hello wo…"), want: "hello wo…"},
+		{name: "trims the space before the ellipsis", line: "hello world", width: w("hello…"), want: "hello…"},
+		{name: "multi-byte runes", line: "héllo wörld", width: w("hé…"), want: "hé…"},
+		{name: "no room", line: "hello", width: 0, want: "…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.Ellipsize(tt.line, tt.width)
+			if got != tt.want {
+				t.Errorf("Ellipsize(%q, %d) = %q, want %q", tt.line, tt.width, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("Ellipsize(%q, %d) = %q is not valid UTF-8", tt.line, tt.width, got)
+			}
+		})
+	}
+}
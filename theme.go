@@ -7,6 +7,9 @@ import (
 
 	"github.com/tinne26/etxt"
 	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
 	"golang.org/x/image/font/gofont/goregular"
 	"golang.org/x/image/font/sfnt"
 	"golang.org/x/image/math/fixed"
@@ -16,9 +19,25 @@ import (
 // Only the font and font size are inputs; everything else derives from them.
 type Theme struct {
 	Font     *sfnt.Font
+	FontPath string // file the font was loaded from, empty for the built-in font
 	FontPx   int
 	ControlH int
 
+	// Faces are the bold, italic and monospace fonts (FaceRegular is Font).
+	// Missing faces use Font.
+	Faces map[Face]*sfnt.Font
+	// Fallbacks are tried in order for runes Font (or the face font) doesn't have,
+	// e.g. symbol, emoji or CJK fonts.
+	Fallbacks []*sfnt.Font
+
+	// Scale is the device scale factor the sizes were derived for (see Scaled);
+	// BaseFontPx is the unscaled font size.
+	Scale      float64
+	BaseFontPx int
+
+	// Ratios are the multipliers the sizes below were derived with.
+	Ratios ThemeRatios
+
 	PadX int
 	PadY int
 
@@ -33,6 +52,9 @@ type Theme struct {
 
 	CheckSize int // checkbox square size
 
+	// Typography are the sizes of the text styles (see Typeface).
+	Typography map[TextStyle]Typeface
+
 	// Validation
 	ErrorFontPx int
 	ErrorGap    int
@@ -49,6 +71,9 @@ type Theme struct {
 	DisabledColor       color.RGBA
 	ErrorTextColor      color.RGBA
 	ErrorBorderColor    color.RGBA
+	InfoColor           color.RGBA
+	SuccessColor        color.RGBA
+	WarningColor        color.RGBA
 	Scrollbar           color.RGBA
 	CaretColor          color.RGBA
 
@@ -62,26 +87,51 @@ type Theme struct {
 	CaretBlink    time.Duration
 	CaretMarginPx int
 
-	renderer *etxt.Renderer
+	// Classes are named styles widgets opt into with Base.SetClass.
+	// They take precedence over the built-in classes (see Class).
+	Classes map[string]Style
+
+	renderers map[Face]*TextRenderer
 }
 
+// Text returns the plain etxt renderer of the regular face, without font fallback.
+// Use TextFace to draw text that may need fallback fonts.
 func (t *Theme) Text() *etxt.Renderer {
-	if t.renderer == nil {
-		r := etxt.NewRenderer()
-		r.Utils().SetCache8MiB()
-		r.Glyph().SetMissHandler(etxt.OnMissNotdef)
-		r.SetFont(t.Font)
-		t.renderer = r
-	}
-
-	t.renderer.SetSize(float64(t.FontPx))
-	t.renderer.SetColor(t.TextColor)
-	t.renderer.SetAlign(etxt.Left | etxt.VertCenter)
-	return t.renderer
+	return t.TextFace(FaceRegular).Renderer
+}
+
+// TextFace returns the renderer for face f, reset to the theme size, color and alignment.
+func (t *Theme) TextFace(f Face) *TextRenderer {
+	if t.renderers == nil {
+		t.renderers = map[Face]*TextRenderer{}
+	}
+
+	r, ok := t.renderers[f]
+	if !ok {
+		font := t.Font
+		fallbacks := t.Fallbacks
+		if ff := t.Faces[f]; ff != nil && f != FaceRegular {
+			font = ff
+			fallbacks = append(append([]*sfnt.Font{}, t.Fallbacks...), t.Font)
+		}
+
+		r = newTextRenderer(font, fallbacks)
+		t.renderers[f] = r
+	}
+
+	r.SetSize(float64(t.FontPx))
+	r.SetColor(t.TextColor)
+	r.SetAlign(etxt.Left | etxt.VertCenter)
+	return r
 }
 
 func (t *Theme) ErrorText() *etxt.Renderer {
-	r := t.Text()
+	return t.ErrorTextFace().Renderer
+}
+
+// ErrorTextFace is ErrorText with font fallback.
+func (t *Theme) ErrorTextFace() *TextRenderer {
+	r := t.TextFace(FaceRegular)
 	r.SetSize(float64(t.ErrorFontPx))
 	r.SetColor(t.ErrorTextColor)
 	return r
@@ -99,10 +149,78 @@ func fontHeight(f *sfnt.Font, sizePx int) (int, error) {
 
 func DefaultTheme() *Theme {
 	f, _ := sfnt.Parse(goregular.TTF)
-	return NewTheme(f, 20)
+	t := NewTheme(f, 20)
+	t.Faces = goFaces()
+
+	return t
+}
+
+// goFaces are the Go bold, italic and mono fonts, the faces of the built-in font.
+func goFaces() map[Face]*sfnt.Font {
+	bold, _ := sfnt.Parse(gobold.TTF)
+	italic, _ := sfnt.Parse(goitalic.TTF)
+	mono, _ := sfnt.Parse(gomono.TTF)
+
+	return map[Face]*sfnt.Font{
+		FaceBold:   bold,
+		FaceItalic: italic,
+		FaceMono:   mono,
+	}
+}
+
+// ThemeRatios are the multipliers NewTheme derives sizes with.
+type ThemeRatios struct {
+	ControlH  float64 // of the font height
+	PadX      float64 // of PadY
+	Radius    float64 // of ControlH
+	BorderW   float64 // of ControlH
+	FocusGap  float64 // of BorderW
+	SpaceS    float64 // of ControlH
+	SpaceM    float64 // of ControlH
+	SpaceL    float64 // of ControlH
+	CheckSize float64 // of the inner control height
+	ErrorFont float64 // of FontPx
+	ErrorGap  float64 // of ControlH
+
+	// Typographic scale, of FontPx.
+	Display float64
+	H1      float64
+	H2      float64
+	H3      float64
+	Caption float64
+	Code    float64
+}
+
+func DefaultThemeRatios() ThemeRatios {
+	return ThemeRatios{
+		// k=1.8 gives a "web input" feel without being too tall.
+		ControlH:  1.8,
+		PadX:      1.6,
+		Radius:    0.22,
+		BorderW:   0.06,
+		FocusGap:  1.5,
+		SpaceS:    0.20,
+		SpaceM:    0.35,
+		SpaceL:    0.60,
+		CheckSize: 0.92,
+		ErrorFont: 0.85,
+		ErrorGap:  0.15,
+
+		Display: 2.4,
+		H1:      1.8,
+		H2:      1.45,
+		H3:      1.2,
+		Caption: 0.85,
+		Code:    0.95,
+	}
 }
 
 func NewTheme(font *sfnt.Font, fontPx int) *Theme {
+	return NewThemeWithRatios(font, fontPx, DefaultThemeRatios())
+}
+
+// NewThemeWithRatios is NewTheme with custom size multipliers.
+func NewThemeWithRatios(font *sfnt.Font, fontPx int, k ThemeRatios) *Theme {
 	if fontPx < 10 {
 		fontPx = 10
 	}
@@ -113,8 +231,7 @@ func NewTheme(font *sfnt.Font, fontPx int) *Theme {
 	}
 
 	// Control height derived from font height.
-	// k=1.8 gives a "web input" feel without being too tall.
-	controlH := int(math.Round(float64(fontH) * 1.8))
+	controlH := int(math.Round(float64(fontH) * k.ControlH))
 	if controlH < fontH+6 {
 		controlH = fontH + 6
 	}
@@ -123,30 +240,30 @@ func NewTheme(font *sfnt.Font, fontPx int) *Theme {
 	if padY < 2 {
 		padY = 2
 	}
-	padX := int(math.Round(float64(padY) * 1.6))
+	padX := int(math.Round(float64(padY) * k.PadX))
 	if padX < 6 {
 		padX = 6
 	}
 
-	radius := int(math.Round(float64(controlH) * 0.22))
+	radius := int(math.Round(float64(controlH) * k.Radius))
 	if radius < 4 {
 		radius = 4
 	}
 
-	borderW := int(math.Round(float64(controlH) * 0.06))
+	borderW := int(math.Round(float64(controlH) * k.BorderW))
 	if borderW < 1 {
 		borderW = 1
 	}
 
 	focusW := borderW
-	focusGap := int(math.Round(float64(borderW) * 1.5))
+	focusGap := int(math.Round(float64(borderW) * k.FocusGap))
 	if focusGap < 2 {
 		focusGap = 2
 	}
 
-	spaceS := int(math.Round(float64(controlH) * 0.20))
-	spaceM := int(math.Round(float64(controlH) * 0.35))
-	spaceL := int(math.Round(float64(controlH) * 0.60))
+	spaceS := int(math.Round(float64(controlH) * k.SpaceS))
+	spaceM := int(math.Round(float64(controlH) * k.SpaceM))
+	spaceL := int(math.Round(float64(controlH) * k.SpaceL))
 	if spaceS < 4 {
 		spaceS = 4
 	}
@@ -163,15 +280,15 @@ func NewTheme(font *sfnt.Font, fontPx int) *Theme {
 	}
 
 	// Keep it visually balanced
-	checkSize = int(math.Round(float64(checkSize) * 0.92))
+	checkSize = int(math.Round(float64(checkSize) * k.CheckSize))
 
 	// Validation
-	errorFontPx := int(math.Round(float64(fontPx) * 0.85))
+	errorFontPx := int(math.Round(float64(fontPx) * k.ErrorFont))
 	if errorFontPx < 10 {
 		errorFontPx = 10
 	}
 
-	errorGap := int(math.Round(float64(controlH) * 0.15))
+	errorGap := int(math.Round(float64(controlH) * k.ErrorGap))
 	if errorGap < 4 {
 		errorGap = 4
 	}
@@ -183,6 +300,10 @@ func NewTheme(font *sfnt.Font, fontPx int) *Theme {
 		PadX:     padX,
 		PadY:     padY,
 
+		Scale:      1,
+		BaseFontPx: fontPx,
+		Ratios:     k,
+
 		Radius:       radius,
 		BorderW:      borderW,
 		FocusRingW:   focusW,
@@ -194,6 +315,8 @@ func NewTheme(font *sfnt.Font, fontPx int) *Theme {
 
 		CheckSize: checkSize,
 
+		Typography: newTypography(font, fontPx, controlH, k),
+
 		ErrorFontPx: errorFontPx,
 		ErrorGap:    errorGap,
 
@@ -208,6 +331,9 @@ func NewTheme(font *sfnt.Font, fontPx int) *Theme {
 		DisabledColor:       color.RGBA{90, 96, 106, 255},
 		ErrorTextColor:      color.RGBA{235, 110, 110, 255},
 		ErrorBorderColor:    color.RGBA{235, 110, 110, 255},
+		InfoColor:           color.RGBA{120, 170, 255, 255},
+		SuccessColor:        color.RGBA{110, 200, 140, 255},
+		WarningColor:        color.RGBA{235, 190, 90, 255},
 
 		CaretColor:    color.RGBA{235, 238, 242, 255},
 		CaretWidthPx:  2,
@@ -215,3 +341,65 @@ func NewTheme(font *sfnt.Font, fontPx int) *Theme {
 		CaretMarginPx: 0,
 	}
 }
+
+// Dp converts a length in logical units to pixels at the theme Scale.
+func (t *Theme) Dp(v int) int {
+	if t.Scale <= 0 {
+		return v
+	}
+
+	return int(math.Round(float64(v) * t.Scale))
+}
+
+// Logical converts px pixels back to logical units, the inverse of Dp.
+func (t *Theme) Logical(px int) int {
+	if t.Scale <= 0 {
+		return px
+	}
+
+	return int(math.Round(float64(px) / t.Scale))
+}
+
+// Scaled returns a copy of t for a device scale factor s (e.g. 2 on retina screens):
+// the font size is BaseFontPx*s and every size is derived again from it. Colors and
+// classes are kept.
+func (t *Theme) Scaled(s float64) *Theme {
+	if s <= 0 {
+		s = 1
+	}
+
+	base := t.BaseFontPx
+	if base == 0 {
+		base = t.FontPx
+	}
+
+	n := NewThemeWithRatios(t.Font, int(math.Round(float64(base)*s)), t.Ratios)
+
+	oldScale := t.Scale
+	if oldScale <= 0 {
+		oldScale = 1
+	}
+
+	c := *t
+	c.renderers = nil
+	c.Scale = s
+	c.BaseFontPx = base
+	c.FontPx = n.FontPx
+	c.ControlH = n.ControlH
+	c.PadX = n.PadX
+	c.PadY = n.PadY
+	c.Radius = n.Radius
+	c.BorderW = n.BorderW
+	c.FocusRingW = n.FocusRingW
+	c.FocusRingGap = n.FocusRingGap
+	c.SpaceS = n.SpaceS
+	c.SpaceM = n.SpaceM
+	c.SpaceL = n.SpaceL
+	c.CheckSize = n.CheckSize
+	c.Typography = n.Typography
+	c.ErrorFontPx = n.ErrorFontPx
+	c.ErrorGap = n.ErrorGap
+	c.CaretWidthPx = max(1, int(math.Round(float64(t.CaretWidthPx)/oldScale*s)))
+
+	return &c
+}
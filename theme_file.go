@@ -0,0 +1,385 @@
+package uikit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+)
+
+// Theme files are JSON (TOML is not supported, to keep the module free of extra
+// dependencies). Every key is optional; missing ones use the NewTheme defaults:
+//
+//	{
+//	  "font":   {"path": "fonts/Inter.ttf", "size": 20},
+//	  "ratios": {"control_h": 1.8, "radius": 0.22},
+//	  "colors": {"text": "#ebeef2", "focus": "#78aaff"}
+//	}
+//
+// Font paths are relative to the theme file. Colors are "#rgb", "#rrggbb" or "#rrggbbaa".
+
+// ThemeError lists the keys of a theme file that were invalid or unknown.
+// The theme returned alongside it is still usable: those keys keep their defaults.
+type ThemeError struct {
+	Keys   []string
+	Errors []error
+}
+
+func (e *ThemeError) Error() string {
+	return fmt.Sprintf("uikit: invalid theme keys: %s", strings.Join(e.Keys, ", "))
+}
+
+func (e *ThemeError) Unwrap() []error {
+	return e.Errors
+}
+
+func (e *ThemeError) add(key string, err error) {
+	e.Keys = append(e.Keys, key)
+	e.Errors = append(e.Errors, fmt.Errorf("%s: %w", key, err))
+}
+
+// sort orders the keys, keeping each error next to its key.
+func (e *ThemeError) sort() {
+	idx := make([]int, len(e.Keys))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return e.Keys[idx[a]] < e.Keys[idx[b]] })
+
+	keys := make([]string, len(idx))
+	errs := make([]error, len(idx))
+	for i, j := range idx {
+		keys[i], errs[i] = e.Keys[j], e.Errors[j]
+	}
+
+	e.Keys, e.Errors = keys, errs
+}
+
+type themeFile struct {
+	Font   themeFileFont      `json:"font"`
+	Ratios map[string]float64 `json:"ratios,omitempty"`
+	Colors map[string]string  `json:"colors,omitempty"`
+}
+
+type themeFileFont struct {
+	Path string `json:"path,omitempty"`
+	Size int    `json:"size,omitempty"`
+}
+
+// LoadTheme reads a theme file. On a *ThemeError the returned theme is still valid.
+func LoadTheme(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTheme(data, filepath.Dir(path))
+}
+
+// ParseTheme decodes a theme file. Font paths are relative to the working directory.
+// On a *ThemeError the returned theme is still valid.
+func ParseTheme(data []byte) (*Theme, error) {
+	return parseTheme(data, "")
+}
+
+func parseTheme(data []byte, dir string) (*Theme, error) {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(data, &top); err != nil {
+		return nil, err
+	}
+
+	// Sections and values are decoded one by one, so a value of the wrong type only
+	// invalidates its own key.
+	verr := &ThemeError{}
+	var font map[string]json.RawMessage
+	var ratios, colors map[string]json.RawMessage
+	for _, k := range sortedKeys(top) {
+		switch k {
+		case "font":
+			decodeThemeValue(verr, k, top[k], &font)
+		case "ratios":
+			decodeThemeValue(verr, k, top[k], &ratios)
+		case "colors":
+			decodeThemeValue(verr, k, top[k], &colors)
+		default:
+			verr.add(k, fmt.Errorf("unknown key"))
+		}
+	}
+
+	var tf themeFileFont
+	for _, k := range sortedKeys(font) {
+		switch k {
+		case "path":
+			decodeThemeValue(verr, "font.path", font[k], &tf.Path)
+		case "size":
+			decodeThemeValue(verr, "font.size", font[k], &tf.Size)
+		default:
+			verr.add("font."+k, fmt.Errorf("unknown key"))
+		}
+	}
+
+	f, _ := sfnt.Parse(goregular.TTF)
+	fontPath := ""
+	if tf.Path != "" {
+		p := tf.Path
+		if !filepath.IsAbs(p) && dir != "" {
+			p = filepath.Join(dir, p)
+		}
+
+		if lf, err := loadFont(p); err != nil {
+			verr.add("font.path", err)
+		} else {
+			f = lf
+			fontPath = tf.Path
+		}
+	}
+
+	size := 20
+	if tf.Size != 0 {
+		if tf.Size < 10 {
+			verr.add("font.size", fmt.Errorf("must be at least 10, got %d", tf.Size))
+		} else {
+			size = tf.Size
+		}
+	}
+
+	k := DefaultThemeRatios()
+	fields := k.fields()
+	for _, name := range sortedKeys(ratios) {
+		key := "ratios." + name
+		dst, ok := fields[name]
+		if !ok {
+			verr.add(key, fmt.Errorf("unknown key"))
+			continue
+		}
+
+		var v float64
+		if !decodeThemeValue(verr, key, ratios[name], &v) {
+			continue
+		}
+
+		if v <= 0 {
+			verr.add(key, fmt.Errorf("must be positive, got %v", v))
+			continue
+		}
+
+		*dst = v
+	}
+
+	t := NewThemeWithRatios(f, size, k)
+	t.FontPath = fontPath
+	if fontPath == "" {
+		t.Faces = goFaces()
+	}
+
+	tokens := t.colorTokens()
+	for _, name := range sortedKeys(colors) {
+		key := "colors." + name
+		dst, ok := tokens[name]
+		if !ok {
+			verr.add(key, fmt.Errorf("unknown key"))
+			continue
+		}
+
+		var hex string
+		if !decodeThemeValue(verr, key, colors[name], &hex) {
+			continue
+		}
+
+		c, err := ParseHexColor(hex)
+		if err != nil {
+			verr.add(key, err)
+			continue
+		}
+
+		*dst = c
+	}
+
+	if len(verr.Keys) > 0 {
+		verr.sort()
+		return t, verr
+	}
+
+	return t, nil
+}
+
+// decodeThemeValue decodes raw into dst, recording a type mismatch under key.
+func decodeThemeValue(verr *ThemeError, key string, raw json.RawMessage, dst any) bool {
+	err := json.Unmarshal(raw, dst)
+	if err == nil {
+		return true
+	}
+
+	var terr *json.UnmarshalTypeError
+	if errors.As(err, &terr) {
+		err = fmt.Errorf("must be %s, got %s", jsonKind(terr.Type), terr.Value)
+	}
+
+	verr.add(key, err)
+	return false
+}
+
+func jsonKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Int:
+		return "an integer"
+	case reflect.Float64:
+		return "a number"
+	case reflect.Map:
+		return "an object"
+	default:
+		return t.String()
+	}
+}
+
+// Save writes the theme to path as JSON: font reference, ratios and every color token.
+func (t *Theme) Save(path string) error {
+	data, err := t.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// MarshalJSON encodes the theme in the theme file format.
+func (t *Theme) MarshalJSON() ([]byte, error) {
+	size := t.BaseFontPx
+	if size == 0 {
+		size = t.FontPx
+	}
+
+	tf := themeFile{
+		Font:   themeFileFont{Path: t.FontPath, Size: size},
+		Ratios: map[string]float64{},
+		Colors: map[string]string{},
+	}
+
+	k := t.Ratios
+	for name, v := range k.fields() {
+		tf.Ratios[name] = *v
+	}
+
+	for name, c := range t.colorTokens() {
+		tf.Colors[name] = formatHexColor(*c)
+	}
+
+	return json.MarshalIndent(tf, "", "  ")
+}
+
+func (k *ThemeRatios) fields() map[string]*float64 {
+	return map[string]*float64{
+		"control_h":  &k.ControlH,
+		"pad_x":      &k.PadX,
+		"radius":     &k.Radius,
+		"border_w":   &k.BorderW,
+		"focus_gap":  &k.FocusGap,
+		"space_s":    &k.SpaceS,
+		"space_m":    &k.SpaceM,
+		"space_l":    &k.SpaceL,
+		"check_size": &k.CheckSize,
+		"error_font": &k.ErrorFont,
+		"error_gap":  &k.ErrorGap,
+		"display":    &k.Display,
+		"h1":         &k.H1,
+		"h2":         &k.H2,
+		"h3":         &k.H3,
+		"caption":    &k.Caption,
+		"code":       &k.Code,
+	}
+}
+
+// colorTokens maps the theme file color names to the theme fields.
+func (t *Theme) colorTokens() map[string]*color.RGBA {
+	return map[string]*color.RGBA{
+		"text":            &t.TextColor,
+		"muted_text":      &t.MutedTextColor,
+		"background":      &t.BackgroundColor,
+		"surface":         &t.SurfaceColor,
+		"surface_hover":   &t.SurfaceHoverColor,
+		"surface_pressed": &t.SurfacePressedColor,
+		"border":          &t.BorderColor,
+		"focus":           &t.FocusColor,
+		"disabled":        &t.DisabledColor,
+		"error_text":      &t.ErrorTextColor,
+		"error_border":    &t.ErrorBorderColor,
+		"info":            &t.InfoColor,
+		"success":         &t.SuccessColor,
+		"warning":         &t.WarningColor,
+		"scrollbar":       &t.Scrollbar,
+		"caret":           &t.CaretColor,
+	}
+}
+
+// Color returns the theme color named as in theme files (e.g. "text", "focus", "success").
+func (t *Theme) Color(name string) (color.RGBA, bool) {
+	c, ok := t.colorTokens()[name]
+	if !ok {
+		return color.RGBA{}, false
+	}
+
+	return *c, true
+}
+
+func loadFont(path string) (*sfnt.Font, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return sfnt.Parse(data)
+}
+
+// ParseHexColor parses "#rgb", "#rrggbb" or "#rrggbbaa".
+func ParseHexColor(s string) (color.RGBA, error) {
+	h, ok := strings.CutPrefix(s, "#")
+	if !ok {
+		return color.RGBA{}, fmt.Errorf("color %q must start with #", s)
+	}
+
+	if len(h) == 3 {
+		h = string([]byte{h[0], h[0], h[1], h[1], h[2], h[2]})
+	}
+	if len(h) == 6 {
+		h += "ff"
+	}
+	if len(h) != 8 {
+		return color.RGBA{}, fmt.Errorf("color %q must be #rgb, #rrggbb or #rrggbbaa", s)
+	}
+
+	v, err := strconv.ParseUint(h, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("color %q is not hexadecimal", s)
+	}
+
+	return color.RGBA{uint8(v >> 24), uint8(v >> 16), uint8(v >> 8), uint8(v)}, nil
+}
+
+func formatHexColor(c color.RGBA) string {
+	if c.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,152 @@
+package uikit
+
+import (
+	"encoding/json"
+	"errors"
+	"image/color"
+	"slices"
+	"testing"
+)
+
+func TestParseTheme(t *testing.T) {
+	def := DefaultTheme()
+
+	tests := []struct {
+		name  string
+		data  string
+		keys  []string // invalid keys reported in a *ThemeError
+		check func(t *testing.T, th *Theme)
+	}{
+		{
+			name: "empty file uses defaults",
+			data: `{}`,
+			check: func(t *testing.T, th *Theme) {
+				if th.FontPx != def.FontPx || th.TextColor != def.TextColor {
+					t.Errorf("FontPx %d, TextColor %v; want the defaults", th.FontPx, th.TextColor)
+				}
+			},
+		},
+		{
+			name: "font size, ratio and colors",
+			data: `{"font": {"size": 24}, "ratios": {"radius": 0.5}, "colors": {"text": "#f00", "focus": "#00ff0080"}}`,
+			check: func(t *testing.T, th *Theme) {
+				if th.FontPx != 24 {
+					t.Errorf("FontPx = %d, want 24", th.FontPx)
+				}
+				if th.Ratios.Radius != 0.5 {
+					t.Errorf("Ratios.Radius = %v, want 0.5", th.Ratios.Radius)
+				}
+				if want := (color.RGBA{255, 0, 0, 255}); th.TextColor != want {
+					t.Errorf("TextColor = %v, want %v", th.TextColor, want)
+				}
+				if want := (color.RGBA{0, 255, 0, 128}); th.FocusColor != want {
+					t.Errorf("FocusColor = %v, want %v", th.FocusColor, want)
+				}
+			},
+		},
+		{
+			name: "invalid keys keep their defaults",
+			data: `{"font": {"size": "big"}, "ratios": {"radius": -1, "zoom": 2}, "colors": {"text": "red", "nope": "#fff", "focus": 3}, "extra": 1}`,
+			keys: []string{"colors.focus", "colors.nope", "colors.text", "extra", "font.size", "ratios.radius", "ratios.zoom"},
+			check: func(t *testing.T, th *Theme) {
+				if th.FontPx != def.FontPx || th.TextColor != def.TextColor || th.FocusColor != def.FocusColor {
+					t.Errorf("invalid keys changed the theme")
+				}
+				if th.Ratios.Radius != def.Ratios.Radius {
+					t.Errorf("Ratios.Radius = %v, want %v", th.Ratios.Radius, def.Ratios.Radius)
+				}
+			},
+		},
+		{
+			name: "font size below minimum",
+			data: `{"font": {"size": 6}}`,
+			keys: []string{"font.size"},
+			check: func(t *testing.T, th *Theme) {
+				if th.FontPx != def.FontPx {
+					t.Errorf("FontPx = %d, want %d", th.FontPx, def.FontPx)
+				}
+			},
+		},
+		{
+			name: "section of the wrong type",
+			data: `{"colors": ["#fff"]}`,
+			keys: []string{"colors"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th, err := ParseTheme([]byte(tt.data))
+			if th == nil {
+				t.Fatalf("ParseTheme() returned no theme: %v", err)
+			}
+
+			var verr *ThemeError
+			switch {
+			case tt.keys == nil && err != nil:
+				t.Errorf("ParseTheme() error = %v", err)
+			case tt.keys != nil && !errors.As(err, &verr):
+				t.Errorf("ParseTheme() error = %v, want a *ThemeError", err)
+			case tt.keys != nil && !slices.Equal(verr.Keys, tt.keys):
+				t.Errorf("ThemeError.Keys = %v, want %v", verr.Keys, tt.keys)
+			case verr != nil && len(verr.Errors) != len(verr.Keys):
+				t.Errorf("ThemeError has %d errors for %d keys", len(verr.Errors), len(verr.Keys))
+			}
+
+			if tt.check != nil {
+				tt.check(t, th)
+			}
+		})
+	}
+}
+
+func TestParseThemeSyntaxError(t *testing.T) {
+	th, err := ParseTheme([]byte(`{"font": `))
+	var verr *ThemeError
+	if th != nil || err == nil || errors.As(err, &verr) {
+		t.Errorf("ParseTheme() = %v, %v; want no theme and a syntax error", th, err)
+	}
+}
+
+func TestThemeJSONRoundTrip(t *testing.T) {
+	th := DefaultTheme()
+	th.FocusColor = color.RGBA{1, 2, 3, 4}
+	th.Ratios.Radius = 0.3
+
+	data, err := json.Marshal(th)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ParseTheme(data)
+	if err != nil {
+		t.Fatalf("ParseTheme() error = %v", err)
+	}
+
+	if got.FontPx != th.FontPx || got.FocusColor != th.FocusColor || got.Ratios != th.Ratios {
+		t.Errorf("round trip: FontPx %d, FocusColor %v, Ratios %+v; want %d, %v, %+v",
+			got.FontPx, got.FocusColor, got.Ratios, th.FontPx, th.FocusColor, th.Ratios)
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    color.RGBA
+		wantErr bool
+	}{
+		{in: "#abc", want: color.RGBA{0xaa, 0xbb, 0xcc, 0xff}},
+		{in: "#102030", want: color.RGBA{0x10, 0x20, 0x30, 0xff}},
+		{in: "#10203040", want: color.RGBA{0x10, 0x20, 0x30, 0x40}},
+		{in: "102030", wantErr: true},
+		{in: "#1020", wantErr: true},
+		{in: "#gggggg", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseHexColor(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseHexColor(%q) = %v, %v", tt.in, got, err)
+		}
+	}
+}
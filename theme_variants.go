@@ -0,0 +1,90 @@
+package uikit
+
+import "image/color"
+
+// Light returns a copy of t with a light palette. Fonts and sizes are kept.
+func (t *Theme) Light() *Theme {
+	c := *t
+	c.renderers = nil
+	t = &c
+
+	t.TextColor = color.RGBA{28, 32, 38, 255}
+	t.MutedTextColor = color.RGBA{96, 104, 116, 255}
+	t.BackgroundColor = color.RGBA{246, 247, 249, 255}
+	t.SurfaceColor = color.RGBA{255, 255, 255, 255}
+	t.SurfaceHoverColor = color.RGBA{236, 239, 243, 255}
+	t.SurfacePressedColor = color.RGBA{224, 228, 234, 255}
+	t.BorderColor = color.RGBA{190, 196, 206, 255}
+	t.FocusColor = color.RGBA{40, 110, 230, 255}
+	t.DisabledColor = color.RGBA{170, 176, 186, 255}
+	t.ErrorTextColor = color.RGBA{200, 40, 40, 255}
+	t.ErrorBorderColor = color.RGBA{200, 40, 40, 255}
+	t.InfoColor = color.RGBA{40, 110, 230, 255}
+	t.SuccessColor = color.RGBA{30, 150, 80, 255}
+	t.WarningColor = color.RGBA{200, 130, 0, 255}
+	t.CaretColor = t.TextColor
+
+	return t
+}
+
+// HighContrast returns a copy of t with a black/white palette, a bright focus color and
+// thicker borders and focus ring. Fonts are kept.
+func (t *Theme) HighContrast() *Theme {
+	c := *t
+	c.Ratios.BorderW *= 2
+	t = c.Scaled(c.Scale)
+
+	t.TextColor = color.RGBA{255, 255, 255, 255}
+	t.MutedTextColor = color.RGBA{220, 220, 220, 255}
+	t.BackgroundColor = color.RGBA{0, 0, 0, 255}
+	t.SurfaceColor = color.RGBA{0, 0, 0, 255}
+	t.SurfaceHoverColor = color.RGBA{40, 40, 40, 255}
+	t.SurfacePressedColor = color.RGBA{70, 70, 70, 255}
+	t.BorderColor = color.RGBA{255, 255, 255, 255}
+	t.FocusColor = color.RGBA{255, 220, 0, 255}
+	t.DisabledColor = color.RGBA{150, 150, 150, 255}
+	t.ErrorTextColor = color.RGBA{255, 110, 110, 255}
+	t.ErrorBorderColor = color.RGBA{255, 110, 110, 255}
+	t.InfoColor = color.RGBA{0, 220, 255, 255}
+	t.SuccessColor = color.RGBA{0, 255, 120, 255}
+	t.WarningColor = color.RGBA{255, 220, 0, 255}
+	t.CaretColor = t.TextColor
+
+	return t
+}
+
+// WithAccent returns a copy of t with a palette derived from a single accent color:
+// focus, info and caret use it and the surfaces are tinted towards it.
+func (t *Theme) WithAccent(accent color.RGBA) *Theme {
+	c := *t
+	c.renderers = nil
+
+	accent.A = 255
+	c.FocusColor = accent
+	c.InfoColor = accent
+	c.CaretColor = accent
+
+	c.BackgroundColor = mixColor(t.BackgroundColor, accent, 0.04)
+	c.SurfaceColor = mixColor(t.SurfaceColor, accent, 0.08)
+	c.SurfaceHoverColor = mixColor(t.SurfaceHoverColor, accent, 0.14)
+	c.SurfacePressedColor = mixColor(t.SurfacePressedColor, accent, 0.20)
+	c.BorderColor = mixColor(t.BorderColor, accent, 0.25)
+
+	return &c
+}
+
+// Invalidate drops cached state derived from the fonts (the text renderers).
+// Call it after changing Font, Faces or Fallbacks on a theme in use; sizes are applied
+// on every use and need no invalidation.
+func (t *Theme) Invalidate() {
+	t.renderers = nil
+}
+
+// mixColor blends a towards b by f in [0, 1].
+func mixColor(a, b color.RGBA, f float64) color.RGBA {
+	mix := func(x, y uint8) uint8 {
+		return uint8(float64(x) + (float64(y)-float64(x))*f + 0.5)
+	}
+
+	return color.RGBA{mix(a.R, b.R), mix(a.G, b.G), mix(a.B, b.B), mix(a.A, b.A)}
+}
@@ -0,0 +1,39 @@
+package uikit
+
+import (
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// durationTicks converts d to a number of Update ticks at the current TPS.
+func durationTicks(d time.Duration) int {
+	tps := ebiten.TPS()
+	if tps <= 0 {
+		tps = 60
+	}
+
+	n := int(math.Round(d.Seconds() * float64(tps)))
+	if n < 1 {
+		n = 1
+	}
+
+	return n
+}
+
+// frameSeconds is the duration of one Update tick at the current TPS.
+func frameSeconds() float64 {
+	tps := ebiten.TPS()
+	if tps <= 0 {
+		tps = 60
+	}
+
+	return 1 / float64(tps)
+}
+
+// DurationTicks converts d to a number of Update ticks at the current TPS (at least 1),
+// for widgets and layouts timing gestures in ticks.
+func DurationTicks(d time.Duration) int {
+	return durationTicks(d)
+}
@@ -0,0 +1,35 @@
+package uikit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationTicks(t *testing.T) {
+	// Outside a running game ebiten reports the default 60 TPS.
+	tests := []struct {
+		d    time.Duration
+		want int
+	}{
+		{0, 1},
+		{-time.Second, 1},
+		{5 * time.Millisecond, 1},
+		{25 * time.Millisecond, 2},
+		{100 * time.Millisecond, 6},
+		{500 * time.Millisecond, 30},
+		{time.Second, 60},
+		{4 * time.Second, 240},
+	}
+
+	for _, tt := range tests {
+		if got := DurationTicks(tt.d); got != tt.want {
+			t.Errorf("DurationTicks(%v) = %d, want %d", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestFrameSeconds(t *testing.T) {
+	if got, want := frameSeconds(), 1.0/60; got != want {
+		t.Errorf("frameSeconds() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,253 @@
+package uikit
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"github.com/erparts/go-uikit/common"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/tinne26/etxt"
+)
+
+const (
+	// DefaultToastTimeout is used when NotifyOptions.Timeout is zero.
+	DefaultToastTimeout = 4 * time.Second
+
+	toastAnimDuration = 180 * time.Millisecond
+)
+
+// Severity selects the accent color of a toast.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeveritySuccess
+	SeverityWarning
+	SeverityError
+)
+
+// Corner is a screen corner used to position toasts.
+type Corner int
+
+const (
+	CornerBottomRight Corner = iota
+	CornerBottomLeft
+	CornerTopRight
+	CornerTopLeft
+)
+
+// NotifyOptions configures a toast shown with Context.Notify.
+// The zero value is an info toast that hides after DefaultToastTimeout.
+type NotifyOptions struct {
+	Severity Severity
+
+	// Timeout before auto-dismiss. Zero uses DefaultToastTimeout, negative keeps
+	// the toast until it is tapped or its action is used.
+	Timeout time.Duration
+
+	// ActionLabel shows an action button on the right side of the toast.
+	ActionLabel string
+	OnAction    func()
+}
+
+type toast struct {
+	msg  string
+	opts NotifyOptions
+
+	shown      string // msg, ellipsized to shownW
+	shownW     int
+	shownTheme *Theme
+
+	life    int     // remaining ticks, <0 means no timeout
+	anim    float64 // 0 hidden .. 1 fully shown
+	closing bool
+	hovered bool
+
+	rect   image.Rectangle
+	action image.Rectangle
+}
+
+// Notify shows a transient toast message. Toasts stack in Context.ToastCorner,
+// pause their timer while hovered and are dismissed on tap.
+func (c *Context) Notify(msg string, opts NotifyOptions) {
+	life := -1
+	if opts.Timeout == 0 {
+		life = durationTicks(DefaultToastTimeout)
+	} else if opts.Timeout > 0 {
+		life = durationTicks(opts.Timeout)
+	}
+
+	c.toasts = append(c.toasts, &toast{msg: msg, opts: opts, life: life})
+}
+
+func (c *Context) severityColor(s Severity) color.RGBA {
+	switch s {
+	case SeveritySuccess:
+		return c.theme.SuccessColor
+	case SeverityWarning:
+		return c.theme.WarningColor
+	case SeverityError:
+		return c.theme.ErrorTextColor
+	default:
+		return c.theme.InfoColor
+	}
+}
+
+// layoutToasts positions toasts from the configured corner inwards. The space
+// each toast takes is scaled by its animation so the stack slides smoothly.
+func (c *Context) layoutToasts() {
+	theme := c.theme
+	screen := c.screen
+	margin := theme.SpaceL
+
+	maxW := screen.Dx() - margin*2
+	minW := theme.ControlH * 6
+
+	left := c.ToastCorner == CornerBottomLeft || c.ToastCorner == CornerTopLeft
+	top := c.ToastCorner == CornerTopLeft || c.ToastCorner == CornerTopRight
+
+	offset := 0
+	for _, t := range c.toasts {
+		txt := theme.TextFace(FaceRegular)
+		w := txt.Measure(t.msg).IntWidth() + theme.PadX*2 + theme.SpaceS
+		actionW := 0
+		if t.opts.ActionLabel != "" {
+			actionW = txt.Measure(t.opts.ActionLabel).IntWidth() + theme.PadX*2
+			w += actionW + theme.SpaceM
+		}
+
+		w = max(min(max(w, minW), maxW), 0)
+		h := theme.ControlH
+
+		msgW := w - theme.PadX*2 - theme.SpaceS
+		if actionW > 0 {
+			msgW -= actionW + theme.SpaceM
+		}
+		msgW = max(msgW, 0)
+		if t.shownTheme != theme || t.shownW != msgW {
+			t.shown = txt.Ellipsize(t.msg, msgW)
+			t.shownW, t.shownTheme = msgW, theme
+		}
+
+		// Slide in from the screen edge of the chosen corner.
+		slide := int((1 - t.anim) * float64(w+margin))
+
+		x := screen.Max.X - margin - w + slide
+		if left {
+			x = screen.Min.X + margin - slide
+		}
+
+		y := screen.Max.Y - margin - offset - h
+		if top {
+			y = screen.Min.Y + margin + offset
+		}
+
+		t.rect = image.Rect(x, y, x+w, y+h)
+		t.action = image.Rectangle{}
+		if actionW > 0 {
+			t.action = image.Rect(t.rect.Max.X-actionW, y, t.rect.Max.X, y+h)
+		}
+
+		offset += int(float64(h+theme.SpaceS) * t.anim)
+	}
+}
+
+// updateToasts advances toast timers and animations and handles taps on them.
+// A press that lands on a toast is consumed so widgets below do not see it.
+func (c *Context) updateToasts() {
+	if len(c.toasts) == 0 {
+		return
+	}
+
+	c.layoutToasts()
+
+	step := 1 / float64(durationTicks(toastAnimDuration))
+	ptr := c.Pointer()
+
+	alive := c.toasts[:0]
+	for _, t := range c.toasts {
+		inside := common.Contains(t.rect, ptr.X, ptr.Y)
+		t.hovered = inside && (!ptr.IsTouch || ptr.IsDown)
+
+		if ptr.IsJustDown && inside && !t.closing {
+			if t.opts.OnAction != nil && common.Contains(t.action, ptr.X, ptr.Y) {
+				t.opts.OnAction()
+			}
+
+			t.closing = true
+			c.ConsumePress()
+			ptr = c.Pointer()
+		}
+
+		if !t.hovered && t.life > 0 {
+			t.life--
+			if t.life == 0 {
+				t.closing = true
+			}
+		}
+
+		if t.closing {
+			t.anim -= step
+			if t.anim <= 0 {
+				continue
+			}
+		} else if t.anim < 1 {
+			t.anim = min(t.anim+step, 1)
+		}
+
+		alive = append(alive, t)
+	}
+
+	c.toasts = alive
+}
+
+func (c *Context) drawToasts(dst *ebiten.Image) {
+	if len(c.toasts) == 0 {
+		return
+	}
+
+	c.layoutToasts()
+
+	theme := c.theme
+	for _, t := range c.toasts {
+		r := t.rect
+		accent := c.severityColor(t.opts.Severity)
+
+		drawRoundedRect(dst, r, theme.Radius, fadeColor(theme.SurfaceColor, t.anim))
+		drawRoundedBorder(dst, r, theme.Radius, theme.BorderW, fadeColor(accent, t.anim))
+
+		stripe := image.Rect(r.Min.X, r.Min.Y, r.Min.X+theme.SpaceS, r.Max.Y)
+		drawRoundedRect(dst, stripe, theme.Radius, fadeColor(accent, t.anim))
+
+		cy := r.Min.Y + r.Dy()/2
+
+		txt := theme.TextFace(FaceRegular)
+		txt.SetColor(fadeColor(theme.TextColor, t.anim))
+		txt.SetAlign(etxt.Left | etxt.VertCenter)
+		txt.Draw(dst, t.shown, stripe.Max.X+theme.PadX, cy)
+
+		if t.action.Empty() {
+			continue
+		}
+
+		if t.hovered && common.Contains(t.action, c.ptr.X, c.ptr.Y) {
+			drawRoundedRect(dst, t.action, theme.Radius, fadeColor(theme.SurfaceHoverColor, t.anim))
+		}
+
+		txt.SetColor(fadeColor(accent, t.anim))
+		txt.SetAlign(etxt.Center)
+		txt.Draw(dst, t.opts.ActionLabel, t.action.Min.X+t.action.Dx()/2, cy)
+	}
+}
+
+// fadeColor scales a premultiplied color by alpha a in [0, 1].
+func fadeColor(col color.RGBA, a float64) color.RGBA {
+	a = max(0, min(1, a))
+	return color.RGBA{
+		R: uint8(float64(col.R) * a),
+		G: uint8(float64(col.G) * a),
+		B: uint8(float64(col.B) * a),
+		A: uint8(float64(col.A) * a),
+	}
+}
@@ -0,0 +1,169 @@
+package uikit
+
+import (
+	"image"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/tinne26/etxt"
+)
+
+// DefaultTooltipDelay is the hover time before a tooltip is shown.
+const DefaultTooltipDelay = 600 * time.Millisecond
+
+// Tooltipped is implemented by widgets that provide their own tooltip text.
+// An empty string means no tooltip. Tooltips registered with Context.SetTooltip take precedence.
+type Tooltipped interface {
+	Tooltip() string
+}
+
+type tooltip struct {
+	text   string
+	widget Widget
+	width  int
+}
+
+type tooltipState struct {
+	target  Widget
+	ticks   int
+	visible bool
+	x, y    int
+}
+
+// SetTooltip registers a text tooltip for w. An empty text removes it.
+func (c *Context) SetTooltip(w Widget, text string) {
+	if text == "" {
+		delete(c.tooltips, w)
+		return
+	}
+
+	c.tooltips[w] = tooltip{text: text}
+}
+
+// SetTooltipWidget registers a widget shown as tooltip for w, laid out with the given width.
+// A nil tip removes it.
+func (c *Context) SetTooltipWidget(w Widget, tip Widget, width int) {
+	if tip == nil {
+		delete(c.tooltips, w)
+		return
+	}
+
+	c.tooltips[w] = tooltip{widget: tip, width: width}
+}
+
+func (c *Context) tooltipFor(w Widget) (tooltip, bool) {
+	if w == nil {
+		return tooltip{}, false
+	}
+
+	if t, ok := c.tooltips[w]; ok {
+		return t, true
+	}
+
+	if tw, ok := any(w).(Tooltipped); ok {
+		if text := tw.Tooltip(); text != "" {
+			return tooltip{text: text}, true
+		}
+	}
+
+	return tooltip{}, false
+}
+
+// updateTooltip tracks how long the pointer stays over the same widget.
+// On desktop it follows the hover target; on touch it requires a long-press.
+func (c *Context) updateTooltip(hover Widget) {
+	target := hover
+	if c.ptr.IsTouch {
+		target = nil
+		if c.ptr.IsDown {
+			target = c.topmostAt(c.ptr.X, c.ptr.Y)
+		}
+	}
+
+	if _, ok := c.tooltipFor(target); !ok {
+		target = nil
+	}
+
+	s := &c.tip
+	if target != s.target {
+		*s = tooltipState{target: target}
+	}
+
+	if target == nil {
+		return
+	}
+
+	// Pressing with the mouse hides the tooltip, like desktop toolkits do.
+	if !c.ptr.IsTouch && c.ptr.IsDown {
+		s.ticks = 0
+		s.visible = false
+		return
+	}
+
+	s.ticks++
+	if !s.visible && s.ticks >= durationTicks(c.TooltipDelay) {
+		s.visible = true
+		s.x, s.y = c.ptr.X, c.ptr.Y
+	}
+}
+
+// drawTooltip renders the active tooltip near the pointer, kept inside dst bounds.
+func (c *Context) drawTooltip(dst *ebiten.Image) {
+	if !c.tip.visible {
+		return
+	}
+
+	tip, ok := c.tooltipFor(c.tip.target)
+	if !ok {
+		return
+	}
+
+	theme := c.theme
+	bounds := dst.Bounds()
+
+	var w, h int
+	if tip.widget != nil {
+		w = c.Dp(tip.width)
+		if w <= 0 {
+			w = theme.ControlH * 6
+		}
+
+		tip.widget.SetFrame(0, 0, w)
+		h = tip.widget.Measure(true).Dy()
+	} else {
+		m := theme.TextFace(FaceRegular).Measure(tip.text)
+		w = m.IntWidth() + theme.PadX*2
+		h = m.IntHeight() + theme.PadY*2
+	}
+
+	// Below-right of the cursor; above the finger on touch so it is not covered.
+	offset := theme.SpaceM
+	x := c.tip.x + offset
+	y := c.tip.y + offset*2
+	if c.ptr.IsTouch {
+		y = c.tip.y - offset*2 - h
+	}
+
+	if y+h > bounds.Max.Y {
+		y = c.tip.y - offset - h
+	}
+
+	x = clampInt(x, bounds.Min.X, bounds.Max.X-w)
+	y = clampInt(y, bounds.Min.Y, bounds.Max.Y-h)
+
+	r := image.Rect(x, y, x+w, y+h)
+
+	if tip.widget != nil {
+		tip.widget.SetFrame(x, y, w)
+		tip.widget.Draw(c, dst)
+		return
+	}
+
+	drawRoundedRect(dst, r, theme.Radius, theme.SurfaceHoverColor)
+	drawRoundedBorder(dst, r, theme.Radius, theme.BorderW, theme.BorderColor)
+
+	t := theme.TextFace(FaceRegular)
+	t.SetColor(theme.TextColor)
+	t.SetAlign(etxt.Left | etxt.Top)
+	t.Draw(dst, tip.text, x+theme.PadX, y+theme.PadY)
+}
@@ -54,11 +54,36 @@ type Hittable interface {
 	HitTest(ctx *Context, x, y int) bool
 }
 
+// IntrinsicWidther is implemented by widgets with a preferred width (e.g. text that can shrink-wrap).
+// Layouts use it to size children that should not fill the available width.
+type IntrinsicWidther interface {
+	IntrinsicWidth(ctx *Context) int
+}
+
+// HeightSetter is implemented by widgets that accept an explicit height in pixels from
+// their parent layout (e.g. Stack, Grid, Container). Zero gives them back their own
+// height. Other widgets keep the fixed theme height.
+type HeightSetter interface {
+	SetFrameHeight(int)
+}
+
+// ThemeSetter is implemented by widgets that can switch theme at runtime (every widget
+// embedding Base). Layouts override it to also update children outside Children().
+type ThemeSetter interface {
+	SetTheme(*Theme)
+}
+
+// Scrollable is implemented by layouts that scroll their content with a Scroller.
+type Scrollable interface {
+	Scroller() *Scroller
+}
+
 // Layout is a Widget that owns children.
 type Layout interface {
 	Widget
 	DrawOverlay(ctx *Context, dst *ebiten.Image)
 	SetHeight(int)
+	SetFrameHeight(int)
 	SetPadding(int, int)
 	Children() []Widget
 	SetChildren([]Widget)
@@ -0,0 +1,98 @@
+package uikit
+
+import (
+	"image/color"
+	"math"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+// TextStyle is a step of the Theme typographic scale.
+type TextStyle int
+
+const (
+	TextBody TextStyle = iota
+	TextDisplay
+	TextH1
+	TextH2
+	TextH3
+	TextCaption
+	TextCode
+)
+
+// Typeface is the size, face and line height a TextStyle is drawn with.
+type Typeface struct {
+	FontPx int
+	Face   Face
+	// Height replaces ControlH for widgets using the style.
+	Height int
+}
+
+func newTypography(f *sfnt.Font, fontPx, controlH int, k ThemeRatios) map[TextStyle]Typeface {
+	face := func(ratio float64, fc Face) Typeface {
+		px := max(10, int(math.Round(float64(fontPx)*ratio)))
+		return Typeface{FontPx: px, Face: fc, Height: textHeight(f, px, k, controlH)}
+	}
+
+	return map[TextStyle]Typeface{
+		TextBody:    {FontPx: fontPx, Face: FaceRegular, Height: controlH},
+		TextDisplay: face(k.Display, FaceBold),
+		TextH1:      face(k.H1, FaceBold),
+		TextH2:      face(k.H2, FaceBold),
+		TextH3:      face(k.H3, FaceBold),
+		TextCaption: face(k.Caption, FaceRegular),
+		TextCode:    face(k.Code, FaceMono),
+	}
+}
+
+// textHeight is the control height for text of px pixels, or fallback if f has no metrics.
+func textHeight(f *sfnt.Font, px int, k ThemeRatios, fallback int) int {
+	fh, err := fontHeight(f, px)
+	if err != nil {
+		return fallback
+	}
+
+	return max(int(math.Round(float64(fh)*k.ControlH)), fh+6)
+}
+
+// TextHeight is the height of a single-line widget with text of fontPx pixels:
+// ControlH for the theme font size, derived like the Typography heights otherwise.
+func (t *Theme) TextHeight(fontPx int) int {
+	if fontPx == t.FontPx {
+		return t.ControlH
+	}
+
+	return textHeight(t.Font, fontPx, t.Ratios, t.ControlH)
+}
+
+// Typeface returns the size, face and height of s. Unknown styles are TextBody.
+func (t *Theme) Typeface(s TextStyle) Typeface {
+	if tf, ok := t.Typography[s]; ok {
+		return tf
+	}
+
+	return Typeface{FontPx: t.FontPx, Face: FaceRegular, Height: t.ControlH}
+}
+
+// TypeStyle is the Style overrides of s: face, size and, for headings and captions, color.
+// TextBody has no overrides. Classes from Theme.Classes and widget styles take precedence
+// over it, built-in classes don't.
+func (t *Theme) TypeStyle(s TextStyle) Style {
+	if s == TextBody {
+		return Style{}
+	}
+
+	tf := t.Typeface(s)
+	st := Style{Face: tf.Face, FontPx: tf.FontPx}
+
+	var text color.RGBA
+	switch s {
+	case TextDisplay, TextH1, TextH2, TextH3:
+		text = t.TextColor
+	case TextCaption:
+		text = t.MutedTextColor
+	}
+
+	st.Text = StateColors{Normal: text}
+	return st
+}
@@ -0,0 +1,51 @@
+package uikit
+
+// Sizes the app passes to widgets and layouts (heights, padding, gaps, item sizes, tracks,
+// breakpoints, window bounds) are in logical units and are converted to pixels with
+// Theme.Dp when laid out, so they follow the scale. Frames, which layouts assign to their
+// children (SetFrame and SetFrameHeight), are in pixels.
+
+// Height is the optional fixed height of a layout: set in logical units by the app or
+// in pixels by the parent layout, which wins. Zero means none.
+type Height struct {
+	v  int
+	px int
+}
+
+func (h *Height) Set(v int) {
+	h.v = max(v, 0)
+}
+
+// SetPx sets the height assigned by the parent layout. Zero gives it back to Set.
+func (h *Height) SetPx(px int) {
+	h.px = max(px, 0)
+}
+
+// Px is the height in pixels for theme t.
+func (h Height) Px(t *Theme) int {
+	if h.px > 0 {
+		return h.px
+	}
+
+	return t.Dp(h.v)
+}
+
+// Gap is the spacing between the children of a layout. Until Set it follows the
+// SpaceS of the current theme.
+type Gap struct {
+	v   int
+	set bool
+}
+
+func (g *Gap) Set(v int) {
+	g.v, g.set = max(v, 0), true
+}
+
+// Px is the gap in pixels for theme t.
+func (g Gap) Px(t *Theme) int {
+	if !g.set {
+		return t.SpaceS
+	}
+
+	return t.Dp(g.v)
+}
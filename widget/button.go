@@ -1,8 +1,9 @@
 package widget
 
 import (
+	"image"
+
 	"github.com/erparts/go-uikit"
-	"github.com/erparts/go-uikit/common"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/tinne26/etxt"
@@ -11,14 +12,15 @@ import (
 // Button is a clickable control with hover/pressed/disabled visuals.
 // - Click triggers on pointer release inside the widget.
 // - Enter/Space triggers click when focused.
+// - Variants: SetClass("primary"), "secondary", "danger" or "ghost" (default "button").
+// - Optional leading/trailing icons, tinted like the label (see Icon).
 type Button struct {
 	uikit.Base
 
-	label   string
-	OnClick func()
-
-	// internal: tracks if the press started inside this widget
-	pressedInside bool
+	label    string
+	leading  *ebiten.Image
+	trailing *ebiten.Image
+	OnClick  func()
 }
 
 func NewButton(theme *uikit.Theme, label string) *Button {
@@ -28,6 +30,11 @@ func NewButton(theme *uikit.Theme, label string) *Button {
 		Base:  uikit.NewBase(cfg),
 		label: label,
 	}
+	b.SetClass("button")
+
+	// Pointer clicks come from the Context on release, so a cancelled press
+	// (e.g. taken over by a scroll drag) never clicks.
+	b.Base.On(uikit.EventClick, b.onClick, false)
 
 	return b
 }
@@ -38,39 +45,56 @@ func (w *Button) SetLabel(s string) {
 	w.label = s
 }
 
-// fireClick dispatches a click event and calls OnClick handler.
-func (w *Button) fireClick() {
-	w.Dispatch(uikit.Event{Widget: w, Type: uikit.EventClick})
-	if w.OnClick != nil {
+// SetLeadingIcon sets the icon drawn before the label. Nil removes it.
+func (w *Button) SetLeadingIcon(img *ebiten.Image) {
+	w.leading = img
+}
+
+// SetTrailingIcon sets the icon drawn after the label. Nil removes it.
+func (w *Button) SetTrailingIcon(img *ebiten.Image) {
+	w.trailing = img
+}
+
+// contentWidth is the width of the label and icons, with the icons sized to the font.
+func (w *Button) contentWidth(ctx *uikit.Context, st uikit.Style) int {
+	t := ctx.Theme().TextFace(st.Face)
+	t.SetSize(float64(st.FontPx))
+
+	cw := t.Measure(w.label).IntWidth()
+	for _, icon := range []*ebiten.Image{w.leading, w.trailing} {
+		if icon == nil {
+			continue
+		}
+
+		if w.label != "" {
+			cw += ctx.Theme().SpaceS
+		}
+		cw += st.FontPx
+	}
+
+	return cw
+}
+
+func (w *Button) IntrinsicWidth(ctx *uikit.Context) int {
+	st := w.ResolveStyle(ctx.Theme())
+	return w.contentWidth(ctx, st) + st.PadX*2
+}
+
+func (w *Button) onClick(e uikit.Event) bool {
+	if w.IsEnabled() && w.OnClick != nil {
 		w.OnClick()
 	}
+
+	return false
 }
 
 func (w *Button) Update(ctx *uikit.Context) {
 	if !w.IsEnabled() {
-		w.pressedInside = false
 		return
 	}
 
 	if w.IsFocused() && (inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace)) {
-		w.fireClick()
-		return
-	}
-
-	ptr := ctx.Pointer()
-	inside := common.Contains(w.Measure(false), ptr.X, ptr.Y)
-
-	// Start press inside
-	if ptr.IsJustDown && inside {
-		w.pressedInside = true
-	}
-
-	if ptr.IsJustDown {
-		if w.pressedInside && inside {
-			w.fireClick()
-		}
-
-		w.pressedInside = false
+		w.Dispatch(uikit.Event{Widget: w, Type: uikit.EventClick})
 	}
 }
 
@@ -78,21 +102,13 @@ func (w *Button) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 	r := w.Measure(false)
 	w.Base.Draw(ctx, dst)
 
-	theme := ctx.Theme()
-	if w.IsEnabled() {
-		if w.IsPressed() {
-			w.DrawRoundedRect(dst, r, theme.Radius, theme.FocusColor)
-		} else if w.IsHovered() {
-			w.DrawRoundedRect(dst, r, theme.Radius, theme.BorderColor)
-		}
-	}
+	st := w.ResolveStyle(ctx.Theme())
 
-	col := theme.TextColor
-	if !w.IsEnabled() {
-		col = theme.DisabledColor
-	}
+	col := st.Text.Get(w.State())
+	cw := w.contentWidth(ctx, st)
 
-	t := theme.Text()
+	t := ctx.Theme().TextFace(st.Face)
+	t.SetSize(float64(st.FontPx))
 	t.SetColor(col)
 	t.SetAlign(etxt.Center)
 
@@ -101,5 +117,31 @@ func (w *Button) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 		offY = 0
 	}
 
-	t.Draw(dst, w.label, r.Min.X+r.Dx()/2, r.Min.Y+r.Dy()/2+offY)
+	cy := r.Min.Y + r.Dy()/2 + offY
+	if w.leading == nil && w.trailing == nil {
+		t.Draw(dst, w.label, r.Min.X+r.Dx()/2, cy)
+		return
+	}
+
+	// Lay out icon, gap, label, gap, icon from the left of the centered content.
+	s := st.FontPx
+	gap := ctx.Theme().SpaceS
+	if w.label == "" {
+		gap = 0
+	}
+
+	x := r.Min.X + (r.Dx()-cw)/2
+	if w.leading != nil {
+		drawIcon(dst, w.leading, image.Rect(x, cy-s/2, x+s, cy-s/2+s), col)
+		x += s + gap
+	}
+
+	t.SetAlign(etxt.Left | etxt.VertCenter)
+	t.Draw(dst, w.label, x, cy)
+	x += t.Measure(w.label).IntWidth()
+
+	if w.trailing != nil {
+		x += gap
+		drawIcon(dst, w.trailing, image.Rect(x, cy-s/2, x+s, cy-s/2+s), col)
+	}
 }
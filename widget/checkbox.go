@@ -46,6 +46,12 @@ func (w *Checkbox) SetChecked(v bool) {
 
 func (w *Checkbox) Checked() bool { return w.checked }
 
+func (w *Checkbox) IntrinsicWidth(ctx *uikit.Context) int {
+	theme := ctx.Theme()
+	boxSize := max(theme.CheckSize, 12)
+	return theme.PadX*2 + boxSize + theme.SpaceS + theme.TextFace(uikit.FaceRegular).Measure(w.label).IntWidth()
+}
+
 func (w *Checkbox) onClick(e uikit.Event) bool {
 	if !w.IsEnabled() {
 		return false
@@ -142,7 +148,7 @@ func (w *Checkbox) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 
 	tx := box.Max.X + theme.SpaceS
 
-	t := theme.Text()
+	t := theme.TextFace(uikit.FaceRegular)
 	t.SetColor(textCol)
 	t.SetAlign(etxt.Left | etxt.VertCenter)
 	t.Draw(dst, w.label, tx, r.Min.Y+r.Dy()/2)
@@ -12,7 +12,7 @@ import (
 // It still participates in focus/invalid layout like any other widget.
 type Container struct {
 	uikit.Base
-	height int
+	height uikit.Height
 
 	OnUpdate func(ctx *uikit.Context, content image.Rectangle)
 	OnDraw   func(ctx *uikit.Context, dst *ebiten.Image)
@@ -24,14 +24,18 @@ func NewContainer(theme *uikit.Theme) *Container {
 	w := &Container{}
 	w.Base = uikit.NewBase(cfg)
 	w.Base.HeightCaculator = func() int {
-		return w.height
+		return w.height.Px(w.Theme())
 	}
 
 	return w
 }
 
 func (w *Container) SetHeight(h int) {
-	w.height = h
+	w.height.Set(h)
+}
+
+func (w *Container) SetFrameHeight(h int) {
+	w.height.SetPx(h)
 }
 
 func (w *Container) Focusable() bool {
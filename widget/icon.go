@@ -0,0 +1,100 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/erparts/go-uikit"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/colorm"
+)
+
+// Icon draws a monochrome image (only its alpha is used) tinted with the text color
+// of the current state, centered in a control-height box.
+type Icon struct {
+	uikit.Base
+
+	img  *ebiten.Image
+	size int
+}
+
+func NewIcon(theme *uikit.Theme, img *ebiten.Image) *Icon {
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	cfg.DrawSurface = false
+	cfg.DrawBorder = false
+
+	return &Icon{
+		Base: uikit.NewBase(cfg),
+		img:  img,
+	}
+}
+
+func (w *Icon) Focusable() bool {
+	return false
+}
+
+func (w *Icon) SetImage(img *ebiten.Image) {
+	w.img = img
+}
+
+// SetSize sets the icon box side. Zero follows the font size.
+func (w *Icon) SetSize(px int) {
+	w.size = max(px, 0)
+}
+
+func (w *Icon) iconSize(st uikit.Style) int {
+	if w.size > 0 {
+		return w.Theme().Dp(w.size)
+	}
+
+	return st.FontPx
+}
+
+func (w *Icon) IntrinsicWidth(ctx *uikit.Context) int {
+	return w.iconSize(w.ResolveStyle(ctx.Theme()))
+}
+
+func (w *Icon) Update(ctx *uikit.Context) {
+	r := w.Measure(false)
+	if r.Dy() == 0 {
+		w.SetFrame(r.Min.X, r.Min.Y, r.Dx())
+	}
+}
+
+func (w *Icon) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	r := w.Base.Draw(ctx, dst)
+
+	st := w.ResolveStyle(ctx.Theme())
+	s := w.iconSize(st)
+	x := r.Min.X + (r.Dx()-s)/2
+	y := r.Min.Y + (r.Dy()-s)/2
+
+	drawIcon(dst, w.img, image.Rect(x, y, x+s, y+s), st.Text.Get(w.State()))
+}
+
+// drawIcon draws img scaled to fit r, keeping its aspect ratio, with every pixel set
+// to col and the img alpha.
+func drawIcon(dst, img *ebiten.Image, r image.Rectangle, col color.RGBA) {
+	if img == nil || r.Dx() <= 0 || r.Dy() <= 0 {
+		return
+	}
+
+	b := img.Bounds()
+	s := min(float64(r.Dx())/float64(b.Dx()), float64(r.Dy())/float64(b.Dy()))
+	dw, dh := float64(b.Dx())*s, float64(b.Dy())*s
+
+	var cm colorm.ColorM
+	cm.Scale(0, 0, 0, float64(col.A)/255)
+	if col.A > 0 {
+		// col is premultiplied; ColorM works on straight colors.
+		a := float64(col.A)
+		cm.Translate(float64(col.R)/a, float64(col.G)/a, float64(col.B)/a, 0)
+	}
+
+	op := &colorm.DrawImageOptions{}
+	op.GeoM.Scale(s, s)
+	op.GeoM.Translate(float64(r.Min.X)+(float64(r.Dx())-dw)/2, float64(r.Min.Y)+(float64(r.Dy())-dh)/2)
+	op.Filter = ebiten.FilterLinear
+
+	colorm.DrawImage(dst, img, cm, op)
+}
@@ -0,0 +1,181 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/erparts/go-uikit"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// ImageMode controls how an Image is scaled into its frame.
+type ImageMode int
+
+const (
+	// ImageFit scales the image to fit inside the frame, keeping its aspect ratio.
+	ImageFit ImageMode = iota
+	// ImageFill scales the image to cover the frame, keeping its aspect ratio; the rest is clipped.
+	ImageFill
+	// ImageStretch scales the image to the frame size.
+	ImageStretch
+	// ImageCenter draws the image unscaled in the middle of the frame, clipped to it.
+	ImageCenter
+)
+
+// Image draws an *ebiten.Image in the layout. With height 0 (the default) its height
+// follows the image aspect ratio at the frame width.
+type Image struct {
+	uikit.Base
+
+	img     *ebiten.Image
+	mode    ImageMode
+	rounded bool
+	height  uikit.Height
+
+	buf *ebiten.Image // offscreen for rounded clipping
+}
+
+func NewImage(theme *uikit.Theme, img *ebiten.Image) *Image {
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	cfg.DrawSurface = false
+	cfg.DrawBorder = false
+
+	w := &Image{
+		Base: uikit.NewBase(cfg),
+		img:  img,
+	}
+	w.HeightCaculator = w.calculateHeight
+
+	return w
+}
+
+func (w *Image) Focusable() bool {
+	return false
+}
+
+func (w *Image) SetImage(img *ebiten.Image) {
+	w.img = img
+}
+
+func (w *Image) SetMode(m ImageMode) {
+	w.mode = m
+}
+
+// SetRounded clips the image corners with the style radius (Theme.Radius by default).
+func (w *Image) SetRounded(v bool) {
+	w.rounded = v
+}
+
+// SetHeight sets a fixed height. Use 0 to follow the image aspect ratio.
+func (w *Image) SetHeight(h int) {
+	w.height.Set(h)
+}
+
+func (w *Image) SetFrameHeight(h int) {
+	w.height.SetPx(h)
+}
+
+// empty reports whether there is nothing to draw, e.g. no image or an empty sub-image.
+func (w *Image) empty() bool {
+	return w.img == nil || w.img.Bounds().Empty()
+}
+
+func (w *Image) calculateHeight() int {
+	if h := w.height.Px(w.Theme()); h > 0 {
+		return h
+	}
+
+	if w.empty() || w.Width() == 0 {
+		return w.Theme().ControlH
+	}
+
+	b := w.img.Bounds()
+	return w.Width() * b.Dy() / b.Dx()
+}
+
+func (w *Image) IntrinsicWidth(ctx *uikit.Context) int {
+	if w.empty() {
+		return 0
+	}
+
+	b := w.img.Bounds()
+	if h := w.height.Px(w.Theme()); h > 0 {
+		return h * b.Dx() / b.Dy()
+	}
+
+	return b.Dx()
+}
+
+func (w *Image) Update(ctx *uikit.Context) {
+	r := w.Measure(false)
+	if r.Dy() == 0 {
+		w.SetFrame(r.Min.X, r.Min.Y, r.Dx())
+	}
+}
+
+// placement returns the scale factors and the rectangle the image is drawn to inside r.
+func (w *Image) placement(r image.Rectangle) (float64, float64, image.Rectangle) {
+	b := w.img.Bounds()
+	sx := float64(r.Dx()) / float64(b.Dx())
+	sy := float64(r.Dy()) / float64(b.Dy())
+
+	switch w.mode {
+	case ImageFit:
+		sx = min(sx, sy)
+		sy = sx
+	case ImageFill:
+		sx = max(sx, sy)
+		sy = sx
+	case ImageCenter:
+		sx, sy = 1, 1
+	}
+
+	dw, dh := int(float64(b.Dx())*sx), int(float64(b.Dy())*sy)
+	x := r.Min.X + (r.Dx()-dw)/2
+	y := r.Min.Y + (r.Dy()-dh)/2
+	return sx, sy, image.Rect(x, y, x+dw, y+dh)
+}
+
+func (w *Image) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	r := w.Base.Draw(ctx, dst)
+	if w.empty() || r.Empty() {
+		return
+	}
+
+	sx, sy, d := w.placement(r)
+	visible := d.Intersect(r)
+	if visible.Empty() {
+		return
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(sx, sy)
+	op.Filter = ebiten.FilterLinear
+
+	radius := w.ResolveStyle(ctx.Theme()).Radius
+	if !w.rounded || radius <= 0 {
+		op.GeoM.Translate(float64(d.Min.X), float64(d.Min.Y))
+		dst.SubImage(visible).(*ebiten.Image).DrawImage(w.img, op)
+		return
+	}
+
+	// Rounded: draw the corner mask offscreen, then the image with source-in over it.
+	if w.buf == nil || w.buf.Bounds().Dx() < visible.Dx() || w.buf.Bounds().Dy() < visible.Dy() {
+		if w.buf != nil {
+			w.buf.Deallocate()
+		}
+		w.buf = ebiten.NewImage(visible.Dx(), visible.Dy())
+	}
+
+	buf := w.buf.SubImage(image.Rect(0, 0, visible.Dx(), visible.Dy())).(*ebiten.Image)
+	buf.Clear()
+	w.DrawRoundedRect(buf, buf.Bounds(), radius, color.RGBA{255, 255, 255, 255})
+
+	op.GeoM.Translate(float64(d.Min.X-visible.Min.X), float64(d.Min.Y-visible.Min.Y))
+	op.Blend = ebiten.BlendSourceIn
+	buf.DrawImage(w.img, op)
+
+	out := &ebiten.DrawImageOptions{}
+	out.GeoM.Translate(float64(visible.Min.X), float64(visible.Min.Y))
+	dst.DrawImage(buf, out)
+}
@@ -1,15 +1,66 @@
 package widget
 
 import (
+	"image"
+	"image/color"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/erparts/go-uikit"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 	"github.com/tinne26/etxt"
 )
 
+// TextAlign is the horizontal alignment of text lines.
+type TextAlign int
+
+const (
+	TextAlignLeft TextAlign = iota
+	TextAlignCenter
+	TextAlignRight
+)
+
+// Label draws static text. By default it is a single control-height line; with wrapping
+// (or explicit newlines) it grows one line height per extra line.
 type Label struct {
 	uikit.Base
 	text     string
 	textFunc func() string
+
+	wrap       bool
+	maxLines   int
+	align      TextAlign
+	selectable bool
+	truncTip   bool
+
+	lay labelLayout
+
+	// Selection, as byte offsets in the text.
+	anchor    int
+	caret     int
+	selecting bool
+}
+
+type labelLayout struct {
+	theme  *uikit.Theme
+	text   string
+	width  int
+	fontPx int
+	face   uikit.Face
+	valid  bool
+
+	lines     []labelLine
+	lineH     int
+	truncated bool
+}
+
+type labelLine struct {
+	text  string // as drawn, may end with an ellipsis
+	start int    // byte offset in the label text
+	n     int    // bytes of the label text shown
+	w     int
 }
 
 func NewLabel(theme *uikit.Theme, text string) *Label {
@@ -19,10 +70,15 @@ func NewLabel(theme *uikit.Theme, text string) *Label {
 
 	base := uikit.NewBase(cfg)
 
-	return &Label{
+	w := &Label{
 		Base: base,
 		text: text,
 	}
+	w.SetClass("label")
+	w.HeightCaculator = w.calculateHeight
+	w.On(uikit.EventPointerDown, w.onPointerDown, false)
+
+	return w
 }
 
 func (w *Label) Focusable() bool {
@@ -37,6 +93,53 @@ func (w *Label) SetTextFunc(fn func() string) {
 	w.textFunc = fn
 }
 
+// SetWrap breaks the text into lines that fit the frame width. Without wrap, lines
+// wider than the frame are ellipsized.
+func (w *Label) SetWrap(v bool) {
+	w.wrap = v
+	w.lay.valid = false
+}
+
+// SetMaxLines limits the lines shown; the last one ends with an ellipsis when text is cut.
+// Lines wider than the frame are ellipsized too. Zero means no limit.
+func (w *Label) SetMaxLines(n int) {
+	w.maxLines = max(n, 0)
+	w.lay.valid = false
+}
+
+func (w *Label) SetAlign(a TextAlign) {
+	w.align = a
+}
+
+// SetSelectable lets the text be selected by dragging and copied with Ctrl+C
+// (see Context.SetClipboard).
+func (w *Label) SetSelectable(v bool) {
+	w.selectable = v
+	if !v {
+		w.anchor, w.caret, w.selecting = 0, 0, false
+	}
+}
+
+// SetTruncationTooltip shows the full text as tooltip while the label is truncated.
+func (w *Label) SetTruncationTooltip(v bool) {
+	w.truncTip = v
+}
+
+// Tooltip implements uikit.Tooltipped.
+func (w *Label) Tooltip() string {
+	if w.truncTip && w.lay.truncated {
+		return w.currentText()
+	}
+
+	return ""
+}
+
+// SelectedText returns the selected part of the text.
+func (w *Label) SelectedText() string {
+	a, b := w.selection()
+	return w.currentText()[a:b]
+}
+
 func (w *Label) currentText() string {
 	if w.textFunc != nil {
 		return w.textFunc()
@@ -45,18 +148,214 @@ func (w *Label) currentText() string {
 	return w.text
 }
 
+func (w *Label) selection() (int, int) {
+	a, b := min(w.anchor, w.caret), max(w.anchor, w.caret)
+	n := len(w.currentText())
+	return min(a, n), min(b, n)
+}
+
+func (w *Label) IntrinsicWidth(ctx *uikit.Context) int {
+	st := w.ResolveStyle(ctx.Theme())
+
+	t := ctx.Theme().TextFace(st.Face)
+	t.SetSize(float64(st.FontPx))
+	return t.Measure(w.currentText()).IntWidth()
+}
+
+func (w *Label) calculateHeight() int {
+	theme := w.Theme()
+	h := theme.TextHeight(w.ResolveStyle(theme).FontPx)
+
+	l := w.layout(theme)
+	if len(l.lines) > 1 {
+		h += (len(l.lines) - 1) * l.lineH
+	}
+
+	return h
+}
+
+// layout splits the text into the lines drawn, cached until text, width, font or theme change.
+func (w *Label) layout(theme *uikit.Theme) *labelLayout {
+	st := w.ResolveStyle(theme)
+	text := w.currentText()
+	width := w.Width()
+
+	l := &w.lay
+	if l.valid && l.theme == theme && l.text == text && l.width == width && l.fontPx == st.FontPx && l.face == st.Face {
+		return l
+	}
+
+	t := theme.TextFace(st.Face)
+	t.SetSize(float64(st.FontPx))
+
+	wrapW := 0
+	if w.wrap {
+		wrapW = width
+	}
+
+	spans := t.Wrap(text, wrapW)
+	cut := w.maxLines > 0 && len(spans) > w.maxLines
+	if cut {
+		spans = spans[:w.maxLines]
+	}
+
+	*l = labelLayout{
+		theme:     theme,
+		text:      text,
+		width:     width,
+		fontPx:    st.FontPx,
+		face:      st.Face,
+		valid:     true,
+		lineH:     max(t.Measure(" ").IntHeight(), 1),
+		truncated: cut,
+	}
+
+	for i, sp := range spans {
+		s := text[sp.Start:sp.End]
+		shown := s
+		if width > 0 && (w.maxLines > 0 || !w.wrap) {
+			if cut && i == len(spans)-1 {
+				shown = t.Ellipsize(s+"…", width)
+			} else {
+				shown = t.Ellipsize(s, width)
+			}
+		}
+
+		n := len(s)
+		if shown != s {
+			l.truncated = true
+			n = len(strings.TrimSuffix(shown, "…"))
+		}
+
+		l.lines = append(l.lines, labelLine{
+			text:  shown,
+			start: sp.Start,
+			n:     n,
+			w:     t.Measure(shown).IntWidth(),
+		})
+	}
+
+	return l
+}
+
+// lineOrigin is the top-left corner of line i inside r.
+func (w *Label) lineOrigin(r image.Rectangle, l *labelLayout, i int) (int, int) {
+	x := r.Min.X
+	switch w.align {
+	case TextAlignCenter:
+		x += (r.Dx() - l.lines[i].w) / 2
+	case TextAlignRight:
+		x += r.Dx() - l.lines[i].w
+	}
+
+	top := r.Min.Y + (r.Dy()-len(l.lines)*l.lineH)/2
+	return x, top + i*l.lineH
+}
+
+// indexAt returns the text offset closest to (x, y).
+func (w *Label) indexAt(ctx *uikit.Context, x, y int) int {
+	l := w.layout(ctx.Theme())
+	if len(l.lines) == 0 {
+		return 0
+	}
+
+	r := w.Measure(false)
+	_, top := w.lineOrigin(r, l, 0)
+	i := min(max((y-top)/l.lineH, 0), len(l.lines)-1)
+	ln := l.lines[i]
+	lx, _ := w.lineOrigin(r, l, i)
+
+	st := w.ResolveStyle(ctx.Theme())
+	t := ctx.Theme().TextFace(st.Face)
+	t.SetSize(float64(st.FontPx))
+
+	prev := 0
+	for j := 0; j < ln.n; {
+		_, size := utf8.DecodeRuneInString(ln.text[j:])
+		next := t.Measure(ln.text[:j+size]).IntWidth()
+		if x-lx < (prev+next)/2 {
+			return ln.start + j
+		}
+
+		prev = next
+		j += size
+	}
+
+	return ln.start + ln.n
+}
+
+func (w *Label) onPointerDown(e uikit.Event) bool {
+	if !w.selectable || e.Pointer == nil {
+		return false
+	}
+
+	w.selecting = true
+	return false
+}
+
 func (w *Label) Update(ctx *uikit.Context) {
 	r := w.Measure(false)
 	if r.Dy() == 0 {
 		w.SetFrame(r.Min.X, r.Min.Y, r.Dx())
 	}
+
+	w.layout(ctx.Theme())
+
+	if !w.selectable {
+		return
+	}
+
+	// Presses anywhere clear the selection; a press on the label starts a new one
+	// (EventPointerDown is dispatched after Update).
+	p := ctx.Pointer()
+	if p.IsJustDown {
+		w.anchor = w.indexAt(ctx, p.X, p.Y)
+		w.caret = w.anchor
+		w.selecting = false
+	}
+
+	if w.selecting {
+		if p.IsDown && w.IsPressed() {
+			w.caret = w.indexAt(ctx, p.X, p.Y)
+		} else {
+			w.selecting = false
+		}
+	}
+
+	ctrl := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	if a, b := w.selection(); a < b && ctrl && inpututil.IsKeyJustPressed(ebiten.KeyC) {
+		ctx.Copy(w.SelectedText())
+	}
 }
 
 func (w *Label) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 	r := w.Base.Draw(ctx, dst)
 
-	ctx.Theme().Text().SetColor(ctx.Theme().MutedTextColor)
-	ctx.Theme().Text().SetAlign(etxt.Left | etxt.VertCenter)
+	theme := ctx.Theme()
+	st := w.ResolveStyle(theme)
+	l := w.layout(theme)
+
+	t := theme.TextFace(st.Face)
+	t.SetSize(float64(st.FontPx))
+	t.SetColor(st.Text.Get(w.State()))
+	t.SetAlign(etxt.Left | etxt.VertCenter)
+
+	a, b := w.selection()
+	for i, ln := range l.lines {
+		x, y := w.lineOrigin(r, l, i)
+
+		if from, to := max(a, ln.start), min(b, ln.start+ln.n); w.selectable && from < to {
+			x0 := x + t.Measure(ln.text[:from-ln.start]).IntWidth()
+			x1 := x + t.Measure(ln.text[:to-ln.start]).IntWidth()
+			vector.DrawFilledRect(dst, float32(x0), float32(y), float32(x1-x0), float32(l.lineH), selectionColor(st.Focus), false)
+		}
+
+		t.Draw(dst, ln.text, x, y+l.lineH/2)
+	}
+}
 
-	ctx.Theme().Text().Draw(dst, w.currentText(), r.Min.X, r.Min.Y+(r.Dy()/2))
+// selectionColor is c at partial opacity (premultiplied).
+func selectionColor(c color.RGBA) color.RGBA {
+	const a = 96
+	return color.RGBA{uint8(int(c.R) * a / 255), uint8(int(c.G) * a / 255), uint8(int(c.B) * a / 255), a}
 }
@@ -0,0 +1,441 @@
+package widget
+
+import (
+	"image"
+
+	"github.com/erparts/go-uikit"
+	"github.com/erparts/go-uikit/common"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/tinne26/etxt"
+)
+
+// MenuItem is a single entry of a dropdown Menu.
+type MenuItem struct {
+	Label    string
+	Shortcut string // display only, e.g. "Ctrl+S"
+	Disabled bool
+
+	// Separator renders a divider line; all other fields are ignored.
+	Separator bool
+
+	OnSelect func()
+}
+
+// Menu is a top-level entry of a MenuBar (e.g. File, Edit, View).
+type Menu struct {
+	Label string
+	Items []MenuItem
+}
+
+// MenuBar is a horizontal bar of menus whose entries open dropdowns.
+// The dropdown is rendered as an overlay (does NOT change layout of other widgets).
+// - Moving the pointer across the bar while a menu is open switches menus.
+// - Alt focuses the bar, arrows navigate, Enter/Space activate, Escape closes.
+type MenuBar struct {
+	uikit.Base
+
+	menus []*Menu
+
+	open   int // open menu index, -1 when closed
+	active int // highlighted title for keyboard navigation, -1 when none
+	hot    int // highlighted item in the open menu, -1 when none
+}
+
+func NewMenuBar(theme *uikit.Theme, menus []*Menu) *MenuBar {
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	cfg.DrawSurface = false
+	cfg.DrawBorder = false
+	cfg.DrawFocus = false
+
+	return &MenuBar{
+		Base:   uikit.NewBase(cfg),
+		menus:  menus,
+		open:   -1,
+		active: -1,
+		hot:    -1,
+	}
+}
+
+func (w *MenuBar) Focusable() bool { return true }
+
+func (w *MenuBar) OverlayActive() bool { return w.open >= 0 }
+
+func (w *MenuBar) IntrinsicWidth(ctx *uikit.Context) int {
+	theme := ctx.Theme()
+
+	width := 0
+	for _, m := range w.menus {
+		width += theme.TextFace(uikit.FaceRegular).Measure(m.Label).IntWidth() + theme.PadX*2
+	}
+
+	return width
+}
+
+func (w *MenuBar) Menus() []*Menu { return w.menus }
+
+func (w *MenuBar) SetMenus(menus []*Menu) {
+	w.menus = menus
+	w.close()
+	w.active = -1
+}
+
+// IsOpen reports whether a dropdown is currently shown.
+func (w *MenuBar) IsOpen() bool { return w.open >= 0 }
+
+func (w *MenuBar) openMenu(i int) {
+	if i < 0 || i >= len(w.menus) {
+		return
+	}
+
+	w.open = i
+	w.active = i
+	w.hot = -1
+}
+
+func (w *MenuBar) close() {
+	w.open = -1
+	w.hot = -1
+}
+
+func (w *MenuBar) titleRects(ctx *uikit.Context) []image.Rectangle {
+	theme := ctx.Theme()
+	r := w.Measure(false)
+
+	rects := make([]image.Rectangle, len(w.menus))
+	x := r.Min.X
+	for i, m := range w.menus {
+		tw := theme.TextFace(uikit.FaceRegular).Measure(m.Label).IntWidth() + theme.PadX*2
+		rects[i] = image.Rect(x, r.Min.Y, x+tw, r.Max.Y)
+		x += tw
+	}
+
+	return rects
+}
+
+func (w *MenuBar) titleAt(ctx *uikit.Context, x, y int) int {
+	for i, tr := range w.titleRects(ctx) {
+		if common.Contains(tr, x, y) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (w *MenuBar) itemHeight(ctx *uikit.Context, it MenuItem) int {
+	if it.Separator {
+		return ctx.Theme().SpaceS
+	}
+
+	return ctx.Theme().ControlH
+}
+
+func (w *MenuBar) menuRect(ctx *uikit.Context, i int) image.Rectangle {
+	if i < 0 || i >= len(w.menus) {
+		return image.Rectangle{}
+	}
+
+	theme := ctx.Theme()
+	title := w.titleRects(ctx)[i]
+
+	width := title.Dx()
+	height := 0
+	for _, it := range w.menus[i].Items {
+		height += w.itemHeight(ctx, it)
+		if it.Separator {
+			continue
+		}
+
+		iw := theme.TextFace(uikit.FaceRegular).Measure(it.Label).IntWidth() + theme.PadX*2
+		if it.Shortcut != "" {
+			iw += theme.SpaceL + theme.TextFace(uikit.FaceRegular).Measure(it.Shortcut).IntWidth()
+		}
+
+		if iw > width {
+			width = iw
+		}
+	}
+
+	return image.Rect(title.Min.X, title.Max.Y, title.Min.X+width, title.Max.Y+height)
+}
+
+func (w *MenuBar) itemAt(ctx *uikit.Context, x, y int) int {
+	if w.open < 0 {
+		return -1
+	}
+
+	list := w.menuRect(ctx, w.open)
+	if !common.Contains(list, x, y) {
+		return -1
+	}
+
+	iy := list.Min.Y
+	for i, it := range w.menus[w.open].Items {
+		h := w.itemHeight(ctx, it)
+		if y >= iy && y < iy+h {
+			if it.Separator || it.Disabled {
+				return -1
+			}
+
+			return i
+		}
+		iy += h
+	}
+
+	return -1
+}
+
+func (w *MenuBar) HitTest(ctx *uikit.Context, x, y int) bool {
+	if w.titleAt(ctx, x, y) >= 0 {
+		return true
+	}
+
+	if w.open >= 0 {
+		return common.Contains(w.menuRect(ctx, w.open), x, y)
+	}
+
+	return false
+}
+
+// activate closes the dropdown and runs the item callback.
+func (w *MenuBar) activate(idx int) {
+	if w.open < 0 || idx < 0 || idx >= len(w.menus[w.open].Items) {
+		return
+	}
+
+	it := w.menus[w.open].Items[idx]
+	if it.Separator || it.Disabled {
+		return
+	}
+
+	w.close()
+	if it.OnSelect != nil {
+		it.OnSelect()
+	}
+}
+
+// moveHot moves the highlighted item by dir (+1/-1), skipping separators and disabled items.
+func (w *MenuBar) moveHot(dir int) {
+	if w.open < 0 {
+		return
+	}
+
+	items := w.menus[w.open].Items
+	n := len(items)
+	if n == 0 {
+		return
+	}
+
+	idx := w.hot
+	if idx < 0 && dir < 0 {
+		idx = n
+	}
+
+	for i := 0; i < n; i++ {
+		idx = (idx + dir + n) % n
+		if !items[idx].Separator && !items[idx].Disabled {
+			w.hot = idx
+			return
+		}
+	}
+}
+
+func (w *MenuBar) moveActive(dir int) {
+	n := len(w.menus)
+	if n == 0 {
+		return
+	}
+
+	idx := w.active
+	if idx < 0 {
+		idx = 0
+	} else {
+		idx = (idx + dir + n) % n
+	}
+
+	if w.open >= 0 {
+		w.openMenu(idx)
+		w.moveHot(1)
+		return
+	}
+
+	w.active = idx
+}
+
+func (w *MenuBar) Update(ctx *uikit.Context) {
+	r := w.Measure(false)
+	if r.Dy() == 0 {
+		w.SetFrame(r.Min.X, r.Min.Y, r.Dx())
+	}
+
+	if !w.IsEnabled() || len(w.menus) == 0 {
+		w.close()
+		w.active = -1
+		return
+	}
+
+	// Alt toggles keyboard focus on the bar.
+	if inpututil.IsKeyJustPressed(ebiten.KeyAlt) {
+		if w.IsFocused() {
+			w.close()
+			w.active = -1
+			ctx.SetFocus(nil)
+		} else {
+			w.active = 0
+			ctx.SetFocus(w)
+		}
+
+		return
+	}
+
+	ptr := ctx.Pointer()
+	title := w.titleAt(ctx, ptr.X, ptr.Y)
+
+	if w.open >= 0 && !ptr.IsTouch {
+		// Desktop behaviour: hovering another title switches the open menu.
+		if title >= 0 && title != w.open {
+			w.openMenu(title)
+		}
+
+		if idx := w.itemAt(ctx, ptr.X, ptr.Y); idx >= 0 {
+			w.hot = idx
+		}
+	}
+
+	if ptr.IsJustDown {
+		switch {
+		case title >= 0 && title == w.open:
+			w.close()
+		case title >= 0:
+			w.openMenu(title)
+		case w.open >= 0 && common.Contains(w.menuRect(ctx, w.open), ptr.X, ptr.Y):
+			if idx := w.itemAt(ctx, ptr.X, ptr.Y); idx >= 0 {
+				w.activate(idx)
+			}
+		default:
+			w.close()
+			w.active = -1
+		}
+
+		return
+	}
+
+	if !w.IsFocused() {
+		if w.open < 0 {
+			w.active = -1
+		}
+
+		return
+	}
+
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft):
+		w.moveActive(-1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyArrowRight):
+		w.moveActive(1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyArrowDown):
+		if w.open < 0 {
+			w.openMenu(max(w.active, 0))
+		}
+		w.moveHot(1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyArrowUp):
+		w.moveHot(-1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter), inpututil.IsKeyJustPressed(ebiten.KeySpace):
+		if w.open >= 0 && w.hot >= 0 {
+			w.activate(w.hot)
+		} else if w.open < 0 {
+			w.openMenu(max(w.active, 0))
+			w.moveHot(1)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		if w.open >= 0 {
+			w.close()
+		} else {
+			w.active = -1
+			ctx.SetFocus(nil)
+		}
+	}
+}
+
+func (w *MenuBar) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	r := w.Base.Draw(ctx, dst)
+
+	theme := ctx.Theme()
+	w.DrawRoundedRect(dst, r, 0, theme.SurfaceColor)
+
+	ptr := ctx.Pointer()
+
+	col := theme.TextColor
+	if !w.IsEnabled() {
+		col = theme.DisabledColor
+	}
+
+	t := theme.TextFace(uikit.FaceRegular)
+	t.SetColor(col)
+	t.SetAlign(etxt.Center)
+
+	for i, tr := range w.titleRects(ctx) {
+		highlight := i == w.open || (w.IsFocused() && i == w.active)
+		if !highlight && w.IsEnabled() && !ptr.IsTouch && common.Contains(tr, ptr.X, ptr.Y) {
+			highlight = true
+		}
+
+		if highlight {
+			w.DrawRoundedRect(dst, tr, 0, theme.SurfaceHoverColor)
+		}
+
+		t.Draw(dst, w.menus[i].Label, tr.Min.X+tr.Dx()/2, tr.Min.Y+tr.Dy()/2)
+	}
+}
+
+func (w *MenuBar) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if w.open < 0 {
+		return
+	}
+
+	theme := ctx.Theme()
+	list := w.menuRect(ctx, w.open)
+
+	w.DrawRoundedRect(dst, list, theme.Radius, theme.SurfaceColor)
+	w.DrawRoundedBorder(dst, list, theme.Radius, theme.BorderW, theme.BorderColor)
+
+	t := theme.TextFace(uikit.FaceRegular)
+
+	y := list.Min.Y
+	for i, it := range w.menus[w.open].Items {
+		h := w.itemHeight(ctx, it)
+		row := image.Rect(list.Min.X, y, list.Max.X, y+h)
+		y += h
+
+		if it.Separator {
+			my := row.Min.Y + row.Dy()/2
+			line := image.Rect(row.Min.X+theme.PadX, my, row.Max.X-theme.PadX, my+theme.BorderW)
+			w.DrawRoundedRect(dst, line, 0, theme.BorderColor)
+			continue
+		}
+
+		if i == w.hot {
+			w.DrawRoundedRect(dst, row, 0, theme.SurfaceHoverColor)
+		}
+
+		col := theme.TextColor
+		if it.Disabled {
+			col = theme.DisabledColor
+		}
+
+		cy := row.Min.Y + row.Dy()/2
+
+		t.SetColor(col)
+		t.SetAlign(etxt.Left | etxt.VertCenter)
+		t.Draw(dst, it.Label, row.Min.X+theme.PadX, cy)
+
+		if it.Shortcut != "" {
+			if !it.Disabled {
+				t.SetColor(theme.MutedTextColor)
+			}
+			t.SetAlign(etxt.Right | etxt.VertCenter)
+			t.Draw(dst, it.Shortcut, row.Max.X-theme.PadX, cy)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"strings"
+
+	"github.com/erparts/go-uikit"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/tinne26/etxt"
+)
+
+// RichLabel draws wrapped text with inline markup:
+//
+//	[b]bold[/b] [i]italic[/i] [code]code[/code]
+//	[color=#ff8800]hex[/color] [color=success]theme color[/color]
+//	[url=target]link[/url] [url]target[/url]
+//
+// Color names are the theme file color names. Tags nest; unknown tags and "\[" are
+// drawn as written. Clicking a link dispatches EventLinkClick with the target in Text.
+type RichLabel struct {
+	uikit.Base
+
+	markup string
+	spans  []richSpan
+
+	lay       richLayout
+	hoverLink string
+	pressLink string // link under the pointer when it was pressed
+}
+
+type richSpan struct {
+	text   string
+	bold   bool
+	italic bool
+	code   bool
+	color  string // hex or theme color name
+	link   string
+}
+
+type richLayout struct {
+	valid  bool
+	theme  *uikit.Theme
+	width  int
+	fontPx int
+
+	frags []richFrag
+	lines int
+	lineH int
+}
+
+// richFrag is a piece of a span placed on a line.
+type richFrag struct {
+	span int
+	text string
+	x    int
+	line int
+	w    int
+}
+
+func NewRichLabel(theme *uikit.Theme, markup string) *RichLabel {
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	cfg.DrawSurface = false
+	cfg.DrawBorder = false
+
+	w := &RichLabel{
+		Base: uikit.NewBase(cfg),
+	}
+	w.SetText(markup)
+	w.HeightCaculator = w.calculateHeight
+	w.On(uikit.EventPointerDown, w.onPointerDown, false)
+	w.On(uikit.EventClick, w.onClick, false)
+
+	return w
+}
+
+func (w *RichLabel) Focusable() bool {
+	return false
+}
+
+// SetText replaces the markup.
+func (w *RichLabel) SetText(markup string) {
+	w.markup = markup
+	w.spans = parseMarkup(markup)
+	w.lay.valid = false
+}
+
+// SetTheme switches the theme and lays the text out again with its fonts.
+func (w *RichLabel) SetTheme(t *uikit.Theme) {
+	w.lay.valid = false
+	w.Base.SetTheme(t)
+}
+
+func (w *RichLabel) Text() string {
+	return w.markup
+}
+
+// PlainText returns the text without markup.
+func (w *RichLabel) PlainText() string {
+	var b strings.Builder
+	for _, sp := range w.spans {
+		b.WriteString(sp.text)
+	}
+
+	return b.String()
+}
+
+func parseMarkup(s string) []richSpan {
+	type tag struct{ name, arg string }
+
+	var stack []tag
+	var spans []richSpan
+	var buf strings.Builder
+
+	flush := func() {
+		if buf.Len() == 0 {
+			return
+		}
+
+		sp := richSpan{text: buf.String()}
+		for _, t := range stack {
+			switch t.name {
+			case "b":
+				sp.bold = true
+			case "i":
+				sp.italic = true
+			case "code":
+				sp.code = true
+			case "color":
+				sp.color = t.arg
+			case "url":
+				sp.link = t.arg
+				if sp.link == "" {
+					sp.link = sp.text
+				}
+			}
+		}
+
+		spans = append(spans, sp)
+		buf.Reset()
+	}
+
+	for i := 0; i < len(s); {
+		if strings.HasPrefix(s[i:], `\[`) {
+			buf.WriteByte('[')
+			i += 2
+			continue
+		}
+
+		if s[i] == '[' {
+			if end := strings.IndexByte(s[i:], ']'); end > 0 {
+				if name, arg, closing, ok := parseTag(s[i+1 : i+end]); ok {
+					flush()
+					if closing {
+						for j := len(stack) - 1; j >= 0; j-- {
+							if stack[j].name == name {
+								stack = append(stack[:j], stack[j+1:]...)
+								break
+							}
+						}
+					} else {
+						stack = append(stack, tag{name, arg})
+					}
+
+					i += end + 1
+					continue
+				}
+			}
+		}
+
+		buf.WriteByte(s[i])
+		i++
+	}
+
+	flush()
+	return spans
+}
+
+func parseTag(raw string) (name, arg string, closing, ok bool) {
+	raw, closing = strings.CutPrefix(raw, "/")
+	name, arg, _ = strings.Cut(raw, "=")
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	switch name {
+	case "b", "i", "code", "color", "url":
+		return name, strings.TrimSpace(arg), closing, true
+	}
+
+	return "", "", false, false
+}
+
+func (w *RichLabel) renderer(theme *uikit.Theme, st uikit.Style, sp richSpan) *uikit.TextRenderer {
+	face := uikit.FaceRegular
+	switch {
+	case sp.code:
+		face = uikit.FaceMono
+	case sp.bold:
+		face = uikit.FaceBold
+	case sp.italic:
+		face = uikit.FaceItalic
+	}
+
+	t := theme.TextFace(face)
+	t.SetSize(float64(st.FontPx))
+	return t
+}
+
+func (w *RichLabel) calculateHeight() int {
+	theme := w.Theme()
+	h := theme.TextHeight(w.ResolveStyle(theme).FontPx)
+
+	l := w.layout(theme)
+	if l.lines > 1 {
+		h += (l.lines - 1) * l.lineH
+	}
+
+	return h
+}
+
+// layout places the spans word by word on lines no wider than the frame.
+func (w *RichLabel) layout(theme *uikit.Theme) *richLayout {
+	st := w.ResolveStyle(theme)
+	width := w.Width()
+
+	l := &w.lay
+	if l.valid && l.theme == theme && l.width == width && l.fontPx == st.FontPx {
+		return l
+	}
+
+	*l = richLayout{valid: true, theme: theme, width: width, fontPx: st.FontPx}
+
+	t := w.renderer(theme, st, richSpan{})
+	l.lineH = max(t.Measure(" ").IntHeight(), 1)
+
+	x, line := 0, 0
+	for si, sp := range w.spans {
+		t := w.renderer(theme, st, sp)
+		for _, tok := range splitWords(sp.text) {
+			if tok == "\n" {
+				x, line = 0, line+1
+				continue
+			}
+
+			tw := t.Measure(tok).IntWidth()
+			if tok[0] == ' ' {
+				if x == 0 && line > 0 {
+					continue
+				}
+			} else if width > 0 && x > 0 && x+tw > width {
+				x, line = 0, line+1
+			}
+
+			if n := len(l.frags); n > 0 && l.frags[n-1].span == si && l.frags[n-1].line == line {
+				f := &l.frags[n-1]
+				f.text += tok
+				f.w = t.Measure(f.text).IntWidth()
+				x = f.x + f.w
+				continue
+			}
+
+			l.frags = append(l.frags, richFrag{span: si, text: tok, x: x, line: line, w: tw})
+			x += tw
+		}
+	}
+
+	l.lines = line + 1
+	return l
+}
+
+// splitWords splits s into words, runs of spaces and newlines.
+func splitWords(s string) []string {
+	var out []string
+	for start := 0; start < len(s); {
+		end := start + 1
+		switch s[start] {
+		case '\n':
+		case ' ':
+			for end < len(s) && s[end] == ' ' {
+				end++
+			}
+		default:
+			for end < len(s) && s[end] != ' ' && s[end] != '\n' {
+				end++
+			}
+		}
+
+		out = append(out, s[start:end])
+		start = end
+	}
+
+	return out
+}
+
+func (w *RichLabel) fragRect(r image.Rectangle, l *richLayout, f richFrag) image.Rectangle {
+	top := r.Min.Y + (r.Dy()-l.lines*l.lineH)/2
+	y := top + f.line*l.lineH
+	return image.Rect(r.Min.X+f.x, y, r.Min.X+f.x+f.w, y+l.lineH)
+}
+
+func (w *RichLabel) linkAt(x, y int) string {
+	l := w.layout(w.Theme())
+	r := w.Measure(false)
+	for _, f := range l.frags {
+		link := w.spans[f.span].link
+		if link != "" && image.Pt(x, y).In(w.fragRect(r, l, f)) {
+			return link
+		}
+	}
+
+	return ""
+}
+
+func (w *RichLabel) onPointerDown(e uikit.Event) bool {
+	w.pressLink = ""
+	if e.Pointer != nil {
+		w.pressLink = w.linkAt(e.Pointer.X, e.Pointer.Y)
+	}
+
+	return false
+}
+
+// onClick follows a link only if the press started on the same link.
+func (w *RichLabel) onClick(e uikit.Event) bool {
+	pressed := w.pressLink
+	w.pressLink = ""
+	if e.Pointer == nil || pressed == "" || w.linkAt(e.Pointer.X, e.Pointer.Y) != pressed {
+		return false
+	}
+
+	w.Dispatch(uikit.Event{Widget: w, Type: uikit.EventLinkClick, Pointer: e.Pointer, Text: pressed})
+	return true
+}
+
+func (w *RichLabel) Update(ctx *uikit.Context) {
+	r := w.Measure(false)
+	if r.Dy() == 0 {
+		w.SetFrame(r.Min.X, r.Min.Y, r.Dx())
+	}
+
+	w.layout(ctx.Theme())
+
+	p := ctx.Pointer()
+	w.hoverLink = ""
+	if w.IsHovered() || w.IsPressed() {
+		w.hoverLink = w.linkAt(p.X, p.Y)
+	}
+}
+
+func (w *RichLabel) spanColor(theme *uikit.Theme, st uikit.Style, sp richSpan) color.RGBA {
+	if sp.color != "" {
+		if c, err := uikit.ParseHexColor(sp.color); err == nil {
+			return c
+		}
+		if c, ok := theme.Color(sp.color); ok {
+			return c
+		}
+	}
+
+	if sp.link != "" && w.IsEnabled() {
+		if sp.link == w.hoverLink {
+			return st.Text.Get(uikit.StateNormal)
+		}
+		return st.Focus
+	}
+
+	return st.Text.Get(w.State())
+}
+
+func (w *RichLabel) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	r := w.Base.Draw(ctx, dst)
+
+	theme := ctx.Theme()
+	st := w.ResolveStyle(theme)
+	l := w.layout(theme)
+
+	for _, f := range l.frags {
+		sp := w.spans[f.span]
+		fr := w.fragRect(r, l, f)
+		col := w.spanColor(theme, st, sp)
+
+		if sp.code {
+			pad := theme.BorderW * 2
+			vector.DrawFilledRect(dst, float32(fr.Min.X-pad), float32(fr.Min.Y), float32(fr.Dx()+pad*2), float32(fr.Dy()), theme.SurfaceHoverColor, false)
+		}
+
+		t := w.renderer(theme, st, sp)
+		t.SetColor(col)
+		t.SetAlign(etxt.Left | etxt.VertCenter)
+		t.Draw(dst, f.text, fr.Min.X, fr.Min.Y+l.lineH/2)
+
+		if sp.link != "" {
+			uy := float32(fr.Min.Y + l.lineH/2 + st.FontPx*2/5)
+			vector.StrokeLine(dst, float32(fr.Min.X), uy, float32(fr.Max.X), uy, float32(theme.BorderW), col, false)
+		}
+	}
+}
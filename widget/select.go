@@ -2,11 +2,13 @@ package widget
 
 import (
 	"image"
+	"image/color"
 	"math"
 
 	"github.com/erparts/go-uikit"
 	"github.com/erparts/go-uikit/common"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 	"github.com/tinne26/etxt"
 )
 
@@ -65,6 +67,18 @@ func (s *Select) SetOptions(opts []SelectOption) {
 	s.clampScroll()
 }
 
+func (s *Select) IntrinsicWidth(ctx *uikit.Context) int {
+	theme := ctx.Theme()
+	t := theme.TextFace(uikit.FaceRegular)
+
+	w := t.Measure(s.placeholder).IntWidth()
+	for _, o := range s.options {
+		w = max(w, t.Measure(o.Label).IntWidth())
+	}
+
+	return w + theme.PadX*2 + theme.SpaceS + chevronSize(theme)
+}
+
 func (s *Select) Index() int { return s.index }
 
 func (s *Select) Value() any {
@@ -249,15 +263,34 @@ func (s *Select) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 
 	centerY := r.Min.Y + (r.Dy() / 2)
 
-	t := theme.Text()
+	t := theme.TextFace(uikit.FaceRegular)
 	t.SetColor(col)
 	t.SetAlign(etxt.Left | etxt.VertCenter)
 	t.Draw(dst, label, r.Min.X+theme.PadX, centerY)
 
-	chev := "▾"
-	t.SetColor(theme.TextColor)
-	t.SetAlign(etxt.Right | etxt.VertCenter)
-	t.Draw(dst, chev, r.Max.X-theme.PadX, centerY)
+	cs := chevronSize(theme)
+	drawChevron(dst, r.Max.X-theme.PadX-cs, centerY, cs, theme.TextColor)
+}
+
+// chevronSize is the width of the dropdown chevron, about a glyph wide.
+func chevronSize(theme *uikit.Theme) int {
+	return max(theme.FontPx/2, 6)
+}
+
+// drawChevron draws a down-pointing triangle of width size, left edge at x and
+// vertically centered on cy. It's drawn as a path so it doesn't depend on font glyphs.
+func drawChevron(dst *ebiten.Image, x, cy, size int, col color.RGBA) {
+	fx, fy, fs := float32(x), float32(cy), float32(size)
+
+	var p vector.Path
+	p.MoveTo(fx, fy-fs/4)
+	p.LineTo(fx+fs, fy-fs/4)
+	p.LineTo(fx+fs/2, fy+fs/4)
+	p.Close()
+
+	op := &vector.DrawPathOptions{AntiAlias: true}
+	op.ColorScale.ScaleWithColor(col)
+	vector.FillPath(dst, &p, nil, op)
 }
 
 func (s *Select) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
@@ -294,7 +327,7 @@ func (s *Select) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
 		}
 
 		bY := row.Min.Y + row.Dy()/2
-		t := theme.Text()
+		t := theme.TextFace(uikit.FaceRegular)
 		t.SetColor(theme.TextColor)
 		t.SetAlign(etxt.Left | etxt.VertCenter)
 		t.Draw(dst, s.options[idx].Label, row.Min.X+theme.PadX, bY)
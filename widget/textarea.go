@@ -58,7 +58,7 @@ func (w *TextArea) calculateHeight() int {
 		lines = 5
 	}
 
-	lineH := w.Theme().Text().Measure(" ").IntHeight()
+	lineH := w.Theme().TextFace(uikit.FaceRegular).Measure(" ").IntHeight()
 	controlH := w.Theme().PadY*2 + lines*lineH
 	if controlH < w.Theme().ControlH {
 		controlH = w.Theme().ControlH
@@ -111,7 +111,7 @@ func (w *TextArea) Update(ctx *uikit.Context) {
 	content := common.Inset(r, theme.PadX, theme.PadY)
 
 	// Only line-height is needed for scroll math.
-	t := theme.Text()
+	t := theme.TextFace(uikit.FaceRegular)
 	lineH := t.Measure(" ").IntHeight()
 	if lineH <= 0 {
 		lineH = 1
@@ -128,7 +128,7 @@ func (w *TextArea) Update(ctx *uikit.Context) {
 		contentH = content.Dy()
 	}
 
-	w.Scroll.Update(ctx, content, contentH)
+	w.Scroll.Update(ctx, content, content.Dx(), contentH)
 
 	if !focused || !enabled {
 		return
@@ -240,9 +240,7 @@ func (w *TextArea) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 	sub := dst.SubImage(content).(*ebiten.Image)
 	ox, oy := sub.Bounds().Min.X, sub.Bounds().Min.Y
 
-	t := theme.Text()
-	t.SetFont(theme.Font)
-	t.SetSize(float64(theme.FontPx))
+	t := theme.TextFace(uikit.FaceRegular)
 	t.SetAlign(etxt.Left | etxt.Top)
 
 	lineH := t.Measure(" ").IntHeight()
@@ -286,7 +284,7 @@ func (w *TextArea) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 		contentH = content.Dy()
 	}
 
-	w.Scroll.DrawBar(sub, theme, content.Dx(), content.Dy(), contentH)
+	w.Scroll.DrawBar(sub, theme, content.Dx(), content.Dy(), content.Dx(), contentH)
 
 	// Caret at end
 	if w.IsFocused() && w.IsEnabled() && w.CaretWidthPx > 0 {
@@ -41,6 +41,12 @@ func (w *TextInput) Focusable() bool { return true }
 func (w *TextInput) WantsIME() bool  { return true }
 func (w *TextInput) Text() string    { return w.text }
 
+// IntrinsicWidth fits the placeholder; typed text scrolls horizontally instead of growing the input.
+func (w *TextInput) IntrinsicWidth(ctx *uikit.Context) int {
+	theme := ctx.Theme()
+	return theme.TextFace(uikit.FaceRegular).Measure(w.placeholder).IntWidth() + theme.PadX*2
+}
+
 // SetText sets the current text value and dispatches a value-change event.
 func (w *TextInput) SetText(s string) {
 	if w.text == s {
@@ -172,7 +178,7 @@ func (w *TextInput) Draw(ctx *uikit.Context, dst *ebiten.Image) {
 		textCol = theme.MutedTextColor
 	}
 
-	t := theme.Text()
+	t := theme.TextFace(uikit.FaceRegular)
 
 	// Horizontal overflow handling (keep the end visible).
 	m := t.Measure(drawStr)
@@ -0,0 +1,616 @@
+package widget
+
+import (
+	"image"
+
+	"github.com/erparts/go-uikit"
+	"github.com/erparts/go-uikit/common"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/tinne26/etxt"
+)
+
+// WindowPlacement is the serializable placement of a Window, e.g. to persist tool layouts as JSON.
+type WindowPlacement struct {
+	ID        string `json:"id"`
+	X         int    `json:"x"`
+	Y         int    `json:"y"`
+	W         int    `json:"w"`
+	H         int    `json:"h"`
+	Minimized bool   `json:"minimized,omitempty"`
+	Closed    bool   `json:"closed,omitempty"`
+}
+
+type windowDrag int
+
+const (
+	dragNone windowDrag = iota
+	dragMove
+	dragResizeRight
+	dragResizeBottom
+	dragResizeCorner
+)
+
+type windowButton int
+
+const (
+	buttonNone windowButton = iota
+	buttonClose
+	buttonMinimize
+)
+
+// Window is a floating panel with a title bar that can be dragged, resized from its
+// right/bottom edges, minimised and closed. The body is any uikit.Layout.
+// Windows live inside a Desktop, which handles z-order and screen clamping.
+type Window struct {
+	uikit.Base
+
+	id    string
+	title string
+	body  uikit.Layout
+
+	x, y, w, h int
+	minimized  bool
+
+	Closable    bool
+	Minimizable bool
+	Resizable   bool
+
+	// OnClose is called after the window is closed with its close button.
+	OnClose func()
+
+	drag      windowDrag
+	button    windowButton // title bar button being pressed
+	grabX     int
+	grabY     int
+	grabStart image.Rectangle
+	bounds    image.Rectangle // Desktop area, used for clamping
+	active    bool            // topmost window of its Desktop
+}
+
+func NewWindow(theme *uikit.Theme, id, title string, body uikit.Layout) *Window {
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	cfg.DrawSurface = false
+	cfg.DrawBorder = false
+	cfg.DrawFocus = false
+
+	w := &Window{
+		Base:        uikit.NewBase(cfg),
+		id:          id,
+		title:       title,
+		w:           theme.ControlH * 10,
+		h:           theme.ControlH * 8,
+		Closable:    true,
+		Minimizable: true,
+		Resizable:   true,
+	}
+
+	w.Base.HeightCaculator = func() int {
+		if w.minimized {
+			return w.titleH()
+		}
+
+		return w.h
+	}
+
+	w.SetBody(body)
+	return w
+}
+
+func (w *Window) Focusable() bool { return false }
+
+func (w *Window) ID() string { return w.id }
+
+func (w *Window) SetTitle(s string) { w.title = s }
+
+func (w *Window) Body() uikit.Layout { return w.body }
+
+func (w *Window) SetBody(body uikit.Layout) {
+	w.body = body
+	if body != nil {
+		w.padBody()
+		body.SetVisible(!w.minimized)
+	}
+}
+
+// padBody insets the body by the theme control padding.
+func (w *Window) padBody() {
+	if w.body != nil {
+		t := w.Theme()
+		w.body.SetPadding(t.Logical(t.PadX), t.Logical(t.PadY))
+	}
+}
+
+// SetBounds sets the window position and size. It is clamped to the Desktop on the next update.
+func (w *Window) SetBounds(x, y, width, height int) {
+	t := w.Theme()
+	w.x, w.y, w.w, w.h = t.Dp(x), t.Dp(y), t.Dp(width), t.Dp(height)
+}
+
+// SetTheme switches the theme, keeping the window bounds when the scale changes.
+func (w *Window) SetTheme(t *uikit.Theme) {
+	if old := w.Theme(); old.Scale != t.Scale {
+		w.x, w.y = t.Dp(old.Logical(w.x)), t.Dp(old.Logical(w.y))
+		w.w, w.h = t.Dp(old.Logical(w.w)), t.Dp(old.Logical(w.h))
+	}
+
+	w.Base.SetTheme(t)
+	w.padBody()
+}
+
+func (w *Window) IsMinimized() bool { return w.minimized }
+
+// SetMinimized collapses the window to its title bar. The body is hidden meanwhile so
+// its widgets can't be hovered or focused.
+func (w *Window) SetMinimized(v bool) {
+	w.minimized = v
+	if w.body != nil {
+		w.body.SetVisible(!v)
+	}
+}
+
+// Close hides the window. Show it again with SetVisible(true).
+func (w *Window) Close() {
+	w.SetVisible(false)
+	w.drag = dragNone
+	w.button = buttonNone
+	if w.OnClose != nil {
+		w.OnClose()
+	}
+}
+
+func (w *Window) Placement() WindowPlacement {
+	t := w.Theme()
+	return WindowPlacement{
+		ID:        w.id,
+		X:         t.Logical(w.x),
+		Y:         t.Logical(w.y),
+		W:         t.Logical(w.w),
+		H:         t.Logical(w.h),
+		Minimized: w.minimized,
+		Closed:    !w.IsVisible(),
+	}
+}
+
+func (w *Window) SetPlacement(s WindowPlacement) {
+	w.SetBounds(s.X, s.Y, s.W, s.H)
+	w.SetMinimized(s.Minimized)
+	w.SetVisible(!s.Closed)
+}
+
+func (w *Window) Children() []uikit.Widget {
+	if w.body == nil {
+		return nil
+	}
+
+	return []uikit.Widget{w.body}
+}
+
+func (w *Window) titleH() int {
+	return w.Theme().ControlH
+}
+
+func (w *Window) minSize() (int, int) {
+	return w.Theme().ControlH * 4, w.titleH() * 2
+}
+
+func (w *Window) titleRect() image.Rectangle {
+	r := w.Measure(false)
+	return image.Rect(r.Min.X, r.Min.Y, r.Max.X, r.Min.Y+w.titleH())
+}
+
+// buttonRects returns the close and minimise buttons; empty when disabled.
+func (w *Window) buttonRects() (image.Rectangle, image.Rectangle) {
+	t := w.titleRect()
+	s := t.Dy()
+
+	var closeR, minR image.Rectangle
+	x := t.Max.X
+	if w.Closable {
+		closeR = image.Rect(x-s, t.Min.Y, x, t.Max.Y)
+		x -= s
+	}
+	if w.Minimizable {
+		minR = image.Rect(x-s, t.Min.Y, x, t.Max.Y)
+	}
+
+	return closeR, minR
+}
+
+// buttonRect returns the rectangle of title bar button b.
+func (w *Window) buttonRect(b windowButton) image.Rectangle {
+	closeR, minR := w.buttonRects()
+	switch b {
+	case buttonClose:
+		return closeR
+	case buttonMinimize:
+		return minR
+	}
+
+	return image.Rectangle{}
+}
+
+// resizeEdge returns the resize drag for a pointer at (x, y), or dragNone.
+func (w *Window) resizeEdge(x, y int) windowDrag {
+	if !w.Resizable || w.minimized {
+		return dragNone
+	}
+
+	r := w.Measure(false)
+	if !common.Contains(r, x, y) {
+		return dragNone
+	}
+
+	edge := w.Theme().SpaceS
+	right := x >= r.Max.X-edge
+	bottom := y >= r.Max.Y-edge
+
+	switch {
+	case right && bottom:
+		return dragResizeCorner
+	case right:
+		return dragResizeRight
+	case bottom:
+		return dragResizeBottom
+	}
+
+	return dragNone
+}
+
+// layout clamps the window into the desktop bounds and positions the body.
+func (w *Window) layout() {
+	b := w.bounds
+	minW, minH := w.minSize()
+
+	w.w = max(w.w, minW)
+	w.h = max(w.h, minH)
+	if b.Dx() > 0 {
+		w.w = min(w.w, b.Dx())
+		w.x = max(min(w.x, b.Max.X-w.w), b.Min.X)
+	}
+	if b.Dy() > 0 {
+		w.h = min(w.h, b.Dy())
+		w.y = max(min(w.y, b.Max.Y-w.titleH()), b.Min.Y)
+	}
+
+	w.Base.SetFrame(w.x, w.y, w.w)
+
+	if w.body != nil && !w.minimized {
+		w.body.SetFrameHeight(w.h - w.titleH())
+		w.body.SetFrame(w.x, w.y+w.titleH(), w.w)
+	}
+}
+
+// press starts the interaction for a pointer press that landed on this window.
+// Title bar buttons act on release, like Button.
+func (w *Window) press(ptr uikit.PointerStatus) {
+	for _, b := range []windowButton{buttonClose, buttonMinimize} {
+		if common.Contains(w.buttonRect(b), ptr.X, ptr.Y) {
+			w.button = b
+			return
+		}
+	}
+
+	w.drag = w.resizeEdge(ptr.X, ptr.Y)
+	if w.drag == dragNone {
+		if !common.Contains(w.titleRect(), ptr.X, ptr.Y) {
+			return
+		}
+
+		w.drag = dragMove
+	}
+
+	w.grabX, w.grabY = ptr.X, ptr.Y
+	w.grabStart = image.Rect(w.x, w.y, w.x+w.w, w.y+w.h)
+}
+
+// release ends a title bar button press, acting when the pointer is still on the button.
+func (w *Window) release(ptr uikit.PointerStatus) {
+	b := w.button
+	w.button = buttonNone
+	if !ptr.IsJustUp || !common.Contains(w.buttonRect(b), ptr.X, ptr.Y) {
+		return
+	}
+
+	switch b {
+	case buttonClose:
+		w.Close()
+	case buttonMinimize:
+		w.SetMinimized(!w.minimized)
+	}
+}
+
+func (w *Window) Update(ctx *uikit.Context) {
+	ptr := ctx.Pointer()
+
+	if w.button != buttonNone && (ptr.IsJustUp || !ptr.IsDown) {
+		w.release(ptr)
+		if !w.IsVisible() {
+			return
+		}
+	}
+
+	if w.drag != dragNone {
+		dx, dy := ptr.X-w.grabX, ptr.Y-w.grabY
+		s := w.grabStart
+
+		switch w.drag {
+		case dragMove:
+			w.x, w.y = s.Min.X+dx, s.Min.Y+dy
+		case dragResizeRight:
+			w.w = s.Dx() + dx
+		case dragResizeBottom:
+			w.h = s.Dy() + dy
+		case dragResizeCorner:
+			w.w, w.h = s.Dx()+dx, s.Dy()+dy
+		}
+
+		if ptr.IsJustUp || !ptr.IsDown {
+			w.drag = dragNone
+		}
+	}
+
+	w.layout()
+
+	if w.body != nil && !w.minimized {
+		w.body.Update(ctx)
+	}
+}
+
+func (w *Window) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	theme := ctx.Theme()
+	r := w.Measure(false)
+	title := w.titleRect()
+
+	w.DrawRoundedRect(dst, r, theme.Radius, theme.BackgroundColor)
+
+	titleCol := theme.SurfaceColor
+	if w.active {
+		titleCol = theme.SurfaceHoverColor
+	}
+	w.DrawRoundedRect(dst, title, theme.Radius, titleCol)
+
+	closeR, minR := w.buttonRects()
+
+	t := theme.TextFace(uikit.FaceRegular)
+	t.SetColor(theme.TextColor)
+	t.SetAlign(etxt.Left | etxt.VertCenter)
+	t.Draw(dst, w.title, title.Min.X+theme.PadX, title.Min.Y+title.Dy()/2)
+
+	ptr := ctx.Pointer()
+	iconW := float32(max(theme.BorderW, 2))
+	for _, br := range []image.Rectangle{closeR, minR} {
+		if br.Empty() {
+			continue
+		}
+
+		switch {
+		case w.button != buttonNone && br == w.buttonRect(w.button):
+			w.DrawRoundedRect(dst, br, theme.Radius, theme.SurfacePressedColor)
+		case !ptr.IsTouch && common.Contains(br, ptr.X, ptr.Y):
+			w.DrawRoundedRect(dst, br, theme.Radius, theme.BorderColor)
+		}
+
+		in := common.Inset(br, br.Dx()/3, br.Dy()/3)
+		if br == closeR {
+			vector.StrokeLine(dst, float32(in.Min.X), float32(in.Min.Y), float32(in.Max.X), float32(in.Max.Y), iconW, theme.TextColor, true)
+			vector.StrokeLine(dst, float32(in.Min.X), float32(in.Max.Y), float32(in.Max.X), float32(in.Min.Y), iconW, theme.TextColor, true)
+		} else {
+			vector.StrokeLine(dst, float32(in.Min.X), float32(in.Max.Y), float32(in.Max.X), float32(in.Max.Y), iconW, theme.TextColor, true)
+		}
+	}
+
+	if w.body != nil && !w.minimized {
+		w.body.Draw(ctx, dst)
+	}
+
+	if w.Resizable && !w.minimized {
+		w.drawGrip(ctx, dst, r, iconW)
+	}
+
+	border := theme.BorderColor
+	if w.active {
+		border = theme.FocusColor
+	}
+	w.DrawRoundedBorder(dst, r, theme.Radius, theme.BorderW, border)
+}
+
+// drawGrip draws the resize grip in the bottom-right corner of r and highlights the
+// edge being hovered or dragged.
+func (w *Window) drawGrip(ctx *uikit.Context, dst *ebiten.Image, r image.Rectangle, lineW float32) {
+	theme := ctx.Theme()
+	ptr := ctx.Pointer()
+
+	edge := w.drag
+	if edge == dragNone && !ptr.IsTouch && w.button == buttonNone {
+		edge = w.resizeEdge(ptr.X, ptr.Y)
+	}
+
+	s := theme.SpaceS
+	switch edge {
+	case dragResizeRight:
+		w.DrawRoundedRect(dst, image.Rect(r.Max.X-s, r.Min.Y+w.titleH(), r.Max.X, r.Max.Y), 0, theme.BorderColor)
+	case dragResizeBottom:
+		w.DrawRoundedRect(dst, image.Rect(r.Min.X, r.Max.Y-s, r.Max.X, r.Max.Y), 0, theme.BorderColor)
+	}
+
+	col := theme.BorderColor
+	if edge == dragResizeCorner {
+		col = theme.FocusColor
+	}
+
+	g := theme.ControlH / 3
+	x1, y1 := float32(r.Max.X-theme.BorderW-2), float32(r.Max.Y-theme.BorderW-2)
+	for i := 1; i <= 3; i++ {
+		d := float32(g * i / 3)
+		vector.StrokeLine(dst, x1-d, y1, x1, y1-d, lineW, col, true)
+	}
+}
+
+// Desktop hosts floating windows. Pressing a window brings it to the front; windows
+// are clamped to the desktop frame. Give it a height (e.g. as root or Dock fill).
+type Desktop struct {
+	uikit.Base
+	windows []*Window // back to front
+
+	height uikit.Height
+}
+
+func NewDesktop(theme *uikit.Theme) *Desktop {
+	cfg := uikit.NewWidgetBaseConfig(theme)
+	cfg.DrawSurface = false
+	cfg.DrawBorder = false
+	cfg.DrawFocus = false
+
+	d := &Desktop{}
+	d.Base = uikit.NewBase(cfg)
+	d.Base.HeightCaculator = func() int {
+		return d.height.Px(d.Theme())
+	}
+
+	return d
+}
+
+func (d *Desktop) Focusable() bool { return false }
+
+func (d *Desktop) SetHeight(h int) { d.height.Set(h) }
+
+func (d *Desktop) SetFrameHeight(h int) { d.height.SetPx(h) }
+
+func (d *Desktop) SetPadding(x, y int) {}
+
+// Windows returns the windows back to front.
+func (d *Desktop) Windows() []*Window { return d.windows }
+
+// Children returns the windows back to front, so the topmost window is hit first.
+func (d *Desktop) Children() []uikit.Widget {
+	ws := make([]uikit.Widget, len(d.windows))
+	for i, w := range d.windows {
+		ws[i] = w
+	}
+
+	return ws
+}
+
+// SetChildren keeps only the *Window widgets of ws.
+func (d *Desktop) SetChildren(ws []uikit.Widget) {
+	d.windows = nil
+	d.Add(ws...)
+}
+
+// Add appends windows on top; other widgets are ignored.
+func (d *Desktop) Add(ws ...uikit.Widget) {
+	for _, w := range ws {
+		if win, ok := w.(*Window); ok {
+			d.windows = append(d.windows, win)
+		}
+	}
+}
+
+func (d *Desktop) Clear() {
+	d.windows = nil
+}
+
+// Raise brings w to the front.
+func (d *Desktop) Raise(w *Window) {
+	for i, win := range d.windows {
+		if win == w {
+			d.windows = append(d.windows[:i], d.windows[i+1:]...)
+			d.windows = append(d.windows, w)
+			return
+		}
+	}
+}
+
+// Placements returns the placement of every window, back to front.
+func (d *Desktop) Placements() []WindowPlacement {
+	ps := make([]WindowPlacement, len(d.windows))
+	for i, w := range d.windows {
+		ps[i] = w.Placement()
+	}
+
+	return ps
+}
+
+// SetPlacements restores placements by window ID, including their z-order.
+func (d *Desktop) SetPlacements(ps []WindowPlacement) {
+	for _, s := range ps {
+		for _, w := range d.windows {
+			if w.id == s.ID {
+				w.SetPlacement(s)
+				d.Raise(w)
+				break
+			}
+		}
+	}
+}
+
+func (d *Desktop) windowAt(x, y int) *Window {
+	for i := len(d.windows) - 1; i >= 0; i-- {
+		w := d.windows[i]
+		if w.IsVisible() && common.Contains(w.Measure(false), x, y) {
+			return w
+		}
+	}
+
+	return nil
+}
+
+func (d *Desktop) Update(ctx *uikit.Context) {
+	ptr := ctx.Pointer()
+	if ptr.IsJustDown {
+		if w := d.windowAt(ptr.X, ptr.Y); w != nil {
+			d.Raise(w)
+			w.press(ptr)
+		}
+	}
+
+	top := d.topVisible()
+	for _, w := range d.windows {
+		w.active = w == top
+		if !w.IsVisible() {
+			continue
+		}
+
+		w.bounds = d.Measure(false)
+		w.Update(ctx)
+	}
+}
+
+func (d *Desktop) Draw(ctx *uikit.Context, dst *ebiten.Image) {
+	if !d.IsVisible() {
+		return
+	}
+
+	for _, w := range d.windows {
+		if !w.IsVisible() {
+			continue
+		}
+
+		w.Draw(ctx, dst)
+	}
+}
+
+func (d *Desktop) topVisible() *Window {
+	for i := len(d.windows) - 1; i >= 0; i-- {
+		if d.windows[i].IsVisible() {
+			return d.windows[i]
+		}
+	}
+
+	return nil
+}
+
+func (d *Desktop) DrawOverlay(ctx *uikit.Context, dst *ebiten.Image) {
+	if !d.IsVisible() {
+		return
+	}
+
+	for _, w := range d.windows {
+		if !w.IsVisible() || w.minimized || w.body == nil {
+			continue
+		}
+
+		w.body.DrawOverlay(ctx, dst)
+	}
+}
@@ -0,0 +1,96 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/erparts/go-uikit"
+)
+
+func TestWindowPlacementRoundTrip(t *testing.T) {
+	p := WindowPlacement{ID: "tools", X: 11, Y: 23, W: 301, H: 207, Minimized: true}
+
+	for _, scale := range []float64{1, 1.25, 1.5, 2} {
+		w := NewWindow(uikit.DefaultTheme().Scaled(scale), "tools", "Tools", nil)
+		w.SetPlacement(p)
+		if got := w.Placement(); got != p {
+			t.Errorf("scale %v: Placement() = %+v, want %+v", scale, got, p)
+		}
+	}
+
+	w := NewWindow(uikit.DefaultTheme(), "tools", "Tools", nil)
+	w.SetPlacement(p)
+	w.SetTheme(w.Theme().Scaled(2))
+	if got := w.Placement(); got != p {
+		t.Errorf("after a scale change Placement() = %+v, want %+v", got, p)
+	}
+}
+
+func TestWindowClamp(t *testing.T) {
+	theme := uikit.DefaultTheme()
+	desk := image.Rect(0, 0, 800, 600)
+	titleH := theme.ControlH
+
+	tests := []struct {
+		name string
+		in   image.Rectangle // x, y, width and height passed to SetBounds
+		want image.Rectangle
+	}{
+		{name: "inside", in: image.Rect(10, 20, 310, 220), want: image.Rect(10, 20, 310, 220)},
+		{name: "past the bottom right", in: image.Rect(700, 590, 1000, 790), want: image.Rect(500, 600-titleH, 800, 800-titleH)},
+		{name: "past the top left", in: image.Rect(-50, -50, 250, 150), want: image.Rect(0, 0, 300, 200)},
+		{name: "larger than the desktop", in: image.Rect(0, 0, 2000, 2000), want: image.Rect(0, 0, 800, 600)},
+		{name: "below the minimum size", in: image.Rect(0, 0, 1, 1), want: image.Rect(0, 0, theme.ControlH*4, titleH*2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWindow(theme, "w", "W", nil)
+			w.SetBounds(tt.in.Min.X, tt.in.Min.Y, tt.in.Dx(), tt.in.Dy())
+			w.bounds = desk
+			w.layout()
+
+			if got := image.Rect(w.x, w.y, w.x+w.w, w.y+w.h); got != tt.want {
+				t.Errorf("bounds = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindowButtonsActOnRelease(t *testing.T) {
+	tests := []struct {
+		name       string
+		releaseOut bool
+		wantClosed bool
+	}{
+		{name: "release on the button", wantClosed: true},
+		{name: "release outside", releaseOut: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWindow(uikit.DefaultTheme(), "w", "W", nil)
+			w.SetBounds(0, 0, 400, 300)
+			w.bounds = image.Rect(0, 0, 800, 600)
+			w.layout()
+
+			closeR, _ := w.buttonRects()
+			c := closeR.Min.Add(closeR.Size().Div(2))
+
+			w.press(uikit.PointerStatus{X: c.X, Y: c.Y, IsDown: true, IsJustDown: true})
+			if !w.IsVisible() {
+				t.Fatal("the window closed on press")
+			}
+
+			up := uikit.PointerStatus{X: c.X, Y: c.Y, IsJustUp: true}
+			if tt.releaseOut {
+				up.X = closeR.Min.X - closeR.Dx()
+			}
+			w.release(up)
+
+			if closed := !w.IsVisible(); closed != tt.wantClosed {
+				t.Errorf("closed = %v, want %v", closed, tt.wantClosed)
+			}
+		})
+	}
+}